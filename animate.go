@@ -0,0 +1,75 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// easings maps easing names to functions which map linear progress in [0, 1] to eased progress.
+var easings = map[string]func(float64) float64{
+	"linear": func(t float64) float64 {
+		return t
+	},
+	"ease-in": func(t float64) float64 {
+		return t * t * t
+	},
+	"ease-out": func(t float64) float64 {
+		return 1 - math.Pow(1-t, 3)
+	},
+	"ease-in-out": func(t float64) float64 {
+		if t < 0.5 {
+			return 4 * t * t * t
+		}
+		return 1 - math.Pow(-2*t+2, 3)/2
+	},
+}
+
+// defaultFrameInterval is used by frameInterval when the canvas frame rate is unusable.
+const defaultFrameInterval = time.Second / 60
+
+// minFrameInterval is the shortest interval returned by frameInterval.
+const minFrameInterval = time.Millisecond
+
+// frameInterval returns the duration of one frame at a frame rate of numerator/denominator,
+// falling back to defaultFrameInterval if the frame rate is not positive.
+func frameInterval(numerator, denominator float64) time.Duration {
+	seconds := denominator / numerator
+	if numerator <= 0 || denominator <= 0 || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return defaultFrameInterval
+	}
+	return max(time.Duration(seconds*float64(time.Second)), minFrameInterval)
+}
+
+// lerp linearly interpolates between from and to.
+func lerp(from, to, t float64) float64 {
+	return from + (to-from)*t
+}
+
+// animate calls step with eased progress every interval until duration has elapsed.
+// The final call to step is always made with a progress of exactly 1.
+func animate(duration, interval time.Duration, easing string, step func(float64) error) error {
+	ease, ok := easings[easing]
+	if !ok {
+		ease = easings["linear"]
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	for {
+		progress := 1.0
+		if duration > 0 {
+			progress = min(float64(time.Since(start))/float64(duration), 1)
+		}
+
+		if err := step(ease(progress)); err != nil {
+			return err
+		}
+		if progress >= 1 {
+			return nil
+		}
+
+		<-ticker.C
+	}
+}
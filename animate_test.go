@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEasingEndpoints(t *testing.T) {
+	for name, ease := range easings {
+		if ease(0) != 0 {
+			t.Errorf("Expected easing '%s' to start at 0 but got %v", name, ease(0))
+		}
+		if ease(1) != 1 {
+			t.Errorf("Expected easing '%s' to end at 1 but got %v", name, ease(1))
+		}
+	}
+}
+
+func TestLerp(t *testing.T) {
+	tests := []struct {
+		from, to, t float64
+		expected    float64
+	}{
+		{0, 100, 0, 0},
+		{0, 100, 0.5, 50},
+		{100, -100, 0.25, 50},
+		{10, 20, 1, 20},
+	}
+
+	for _, test := range tests {
+		result := lerp(test.from, test.to, test.t)
+		if result != test.expected {
+			t.Errorf("Expected %v but got %v", test.expected, result)
+		}
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	var steps []float64
+	err := animate(50*time.Millisecond, 10*time.Millisecond, "linear", func(t float64) error {
+		steps = append(steps, t)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to animate: %v", err)
+	}
+	if len(steps) < 2 {
+		t.Fatalf("Expected at least 2 steps, got %d", len(steps))
+	}
+	if steps[len(steps)-1] != 1 {
+		t.Fatalf("Expected final step to be 1, got %v", steps[len(steps)-1])
+	}
+}
+
+func TestFrameInterval(t *testing.T) {
+	tests := []struct {
+		numerator, denominator float64
+		expected               time.Duration
+	}{
+		{60, 1, time.Second / 60},
+		{30000, 1001, 33366666 * time.Nanosecond},
+		{0, 1, defaultFrameInterval},
+		{60, 0, defaultFrameInterval},
+		{-30, 1, defaultFrameInterval},
+		{1e9, 1, minFrameInterval},
+	}
+
+	for _, test := range tests {
+		result := frameInterval(test.numerator, test.denominator)
+		if result != test.expected {
+			t.Errorf("Expected frame interval of %v/%v to be %v but got %v",
+				test.numerator, test.denominator, test.expected, result)
+		}
+	}
+}
@@ -1,28 +1,45 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/andreykaipov/goobs/api/requests/filters"
+	"github.com/andreykaipov/goobs/api/typedefs"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 )
 
 // FilterCmd provides commands to manage filters in OBS Studio.
 type FilterCmd struct {
-	List    FilterListCmd    `cmd:"" help:"List all filters."  aliases:"ls"  completion-enabled-command-alias:"false"`
-	Enable  FilterEnableCmd  `cmd:"" help:"Enable filter."     aliases:"on"  completion-enabled-command-alias:"false"`
-	Disable FilterDisableCmd `cmd:"" help:"Disable filter."    aliases:"off" completion-enabled-command-alias:"false"`
-	Toggle  FilterToggleCmd  `cmd:"" help:"Toggle filter."     aliases:"tg"  completion-enabled-command-alias:"false"`
-	Status  FilterStatusCmd  `cmd:"" help:"Get filter status." aliases:"ss"  completion-enabled-command-alias:"false"`
+	List     FilterListCmd     `cmd:"" help:"List all filters."                        aliases:"ls"  completion-enabled-command-alias:"false"`
+	Enable   FilterEnableCmd   `cmd:"" help:"Enable filter."                           aliases:"on"  completion-enabled-command-alias:"false"`
+	Disable  FilterDisableCmd  `cmd:"" help:"Disable filter."                          aliases:"off" completion-enabled-command-alias:"false"`
+	Toggle   FilterToggleCmd   `cmd:"" help:"Toggle filter."                           aliases:"tg"  completion-enabled-command-alias:"false"`
+	Status   FilterStatusCmd   `cmd:"" help:"Get filter status."                       aliases:"ss"  completion-enabled-command-alias:"false"`
+	Create   FilterCreateCmd   `cmd:"" help:"Create filter."                           aliases:"c"   completion-enabled-command-alias:"false"`
+	Remove   FilterRemoveCmd   `cmd:"" help:"Remove filter."                           aliases:"rm"  completion-enabled-command-alias:"false"`
+	Rename   FilterRenameCmd   `cmd:"" help:"Rename filter."                           aliases:"rn"  completion-enabled-command-alias:"false"`
+	Settings FilterSettingsCmd `cmd:"" help:"Get/Set filter settings."                 aliases:"st"  completion-enabled-command-alias:"false"`
+	Kinds    FilterKindsCmd    `cmd:"" help:"List filter kinds."                       aliases:"k"   completion-enabled-command-alias:"false"`
+	Order    FilterOrderCmd    `cmd:"" help:"Change the position of a filter."         aliases:"o"   completion-enabled-command-alias:"false"`
+	Copy     FilterCopyCmd     `cmd:"" help:"Copy filters from one source to another." aliases:"cp"  completion-enabled-command-alias:"false"`
+	Export   FilterExportCmd   `cmd:"" help:"Export filters as JSON."                  aliases:"ex"  completion-enabled-command-alias:"false"`
+	Import   FilterImportCmd   `cmd:"" help:"Import filters from JSON."                aliases:"im"  completion-enabled-command-alias:"false"`
+	Animate  FilterAnimateCmd  `cmd:"" help:"Animate a numeric filter setting."        aliases:"an"  completion-enabled-command-alias:"false"`
+	Show     FilterShowCmd     `cmd:"" help:"Show filter settings and their defaults." aliases:"sh"  completion-enabled-command-alias:"false"`
+	Diff     FilterDiffCmd     `cmd:"" help:"Compare the filters of two sources."      aliases:"df"  completion-enabled-command-alias:"false"`
 }
 
 // FilterListCmd provides a command to list all filters in a scene.
 type FilterListCmd struct {
-	SourceName string `arg:"" help:"Name of the source to list filters from." default:""`
+	SourceName string `arg:"" help:"Name or UUID of the source to list filters from." default:""`
 }
 
 // Run executes the command to list all filters in a scene.
@@ -36,9 +53,9 @@ func (cmd *FilterListCmd) Run(ctx *context) error {
 		cmd.SourceName = currentScene.SceneName
 	}
 
-	sourceFilters, err := ctx.Client.Filters.GetSourceFilterList(
-		filters.NewGetSourceFilterListParams().WithSourceName(cmd.SourceName),
-	)
+	params := filters.NewGetSourceFilterListParams()
+	params = withSource(params, cmd.SourceName)
+	sourceFilters, err := ctx.Client.Filters.GetSourceFilterList(params)
 	if err != nil {
 		return err
 	}
@@ -110,18 +127,23 @@ func (cmd *FilterListCmd) Run(ctx *context) error {
 
 // FilterEnableCmd provides a command to enable a filter in a scene.
 type FilterEnableCmd struct {
-	SourceName string `arg:"" help:"Name of the source to enable filter from."`
+	SourceName string `arg:"" help:"Name or UUID of the source to enable filter from."`
 	FilterName string `arg:"" help:"Name of the filter to enable."`
 }
 
 // Run executes the command to enable a filter in a scene.
 func (cmd *FilterEnableCmd) Run(ctx *context) error {
-	_, err := ctx.Client.Filters.SetSourceFilterEnabled(
-		filters.NewSetSourceFilterEnabledParams().
-			WithSourceName(cmd.SourceName).
-			WithFilterName(cmd.FilterName).
-			WithFilterEnabled(true),
-	)
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewSetSourceFilterEnabledParams().
+		WithFilterName(cmd.FilterName).
+		WithFilterEnabled(true)
+	params = withSource(params, cmd.SourceName)
+	_, err = ctx.Client.Filters.SetSourceFilterEnabled(params)
 	if err != nil {
 		return fmt.Errorf("failed to enable filter %s on source %s: %w",
 			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
@@ -133,18 +155,23 @@ func (cmd *FilterEnableCmd) Run(ctx *context) error {
 
 // FilterDisableCmd provides a command to disable a filter in a scene.
 type FilterDisableCmd struct {
-	SourceName string `arg:"" help:"Name of the source to disable filter from."`
+	SourceName string `arg:"" help:"Name or UUID of the source to disable filter from."`
 	FilterName string `arg:"" help:"Name of the filter to disable."`
 }
 
 // Run executes the command to disable a filter in a scene.
 func (cmd *FilterDisableCmd) Run(ctx *context) error {
-	_, err := ctx.Client.Filters.SetSourceFilterEnabled(
-		filters.NewSetSourceFilterEnabledParams().
-			WithSourceName(cmd.SourceName).
-			WithFilterName(cmd.FilterName).
-			WithFilterEnabled(false),
-	)
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewSetSourceFilterEnabledParams().
+		WithFilterName(cmd.FilterName).
+		WithFilterEnabled(false)
+	params = withSource(params, cmd.SourceName)
+	_, err = ctx.Client.Filters.SetSourceFilterEnabled(params)
 	if err != nil {
 		return fmt.Errorf("failed to disable filter %s on source %s: %w",
 			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
@@ -156,29 +183,30 @@ func (cmd *FilterDisableCmd) Run(ctx *context) error {
 
 // FilterToggleCmd provides a command to toggle a filter in a scene.
 type FilterToggleCmd struct {
-	SourceName string `arg:"" help:"Name of the source to toggle filter from."`
+	SourceName string `arg:"" help:"Name or UUID of the source to toggle filter from."`
 	FilterName string `arg:"" help:"Name of the filter to toggle."`
 }
 
 // Run executes the command to toggle a filter in a scene.
 func (cmd *FilterToggleCmd) Run(ctx *context) error {
-	filter, err := ctx.Client.Filters.GetSourceFilter(
-		filters.NewGetSourceFilterParams().
-			WithSourceName(cmd.SourceName).
-			WithFilterName(cmd.FilterName),
-	)
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	getParams := filters.NewGetSourceFilterParams().WithFilterName(cmd.FilterName)
+	setParams := filters.NewSetSourceFilterEnabledParams().WithFilterName(cmd.FilterName)
+	getParams = withSource(getParams, cmd.SourceName)
+	setParams = withSource(setParams, cmd.SourceName)
+	filter, err := ctx.Client.Filters.GetSourceFilter(getParams)
 	if err != nil {
 		return fmt.Errorf("failed to get filter %s on source %s: %w",
 			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
 	}
 
 	newStatus := !filter.FilterEnabled
-	_, err = ctx.Client.Filters.SetSourceFilterEnabled(
-		filters.NewSetSourceFilterEnabledParams().
-			WithSourceName(cmd.SourceName).
-			WithFilterName(cmd.FilterName).
-			WithFilterEnabled(newStatus),
-	)
+	_, err = ctx.Client.Filters.SetSourceFilterEnabled(setParams.WithFilterEnabled(newStatus))
 	if err != nil {
 		return fmt.Errorf("failed to toggle filter %s on source %s: %w",
 			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
@@ -196,17 +224,21 @@ func (cmd *FilterToggleCmd) Run(ctx *context) error {
 
 // FilterStatusCmd provides a command to get the status of a filter in a scene.
 type FilterStatusCmd struct {
-	SourceName string `arg:"" help:"Name of the source to get filter status from."`
+	SourceName string `arg:"" help:"Name or UUID of the source to get filter status from."`
 	FilterName string `arg:"" help:"Name of the filter to get status."`
 }
 
 // Run executes the command to get the status of a filter in a scene.
 func (cmd *FilterStatusCmd) Run(ctx *context) error {
-	filter, err := ctx.Client.Filters.GetSourceFilter(
-		filters.NewGetSourceFilterParams().
-			WithSourceName(cmd.SourceName).
-			WithFilterName(cmd.FilterName),
-	)
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewGetSourceFilterParams().WithFilterName(cmd.FilterName)
+	params = withSource(params, cmd.SourceName)
+	filter, err := ctx.Client.Filters.GetSourceFilter(params)
 	if err != nil {
 		return fmt.Errorf("failed to get status of filter %s on source %s: %w",
 			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
@@ -220,3 +252,923 @@ func (cmd *FilterStatusCmd) Run(ctx *context) error {
 	}
 	return nil
 }
+
+// FilterCreateCmd provides a command to create a filter on a source.
+type FilterCreateCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source to create the filter on."`
+	FilterName string `arg:"" help:"Name for the filter."`
+	Kind       string `arg:"" help:"Filter kind (e.g., color_filter_v2, noise_suppress_filter_v2)."`
+
+	SettingsJSON string `flag:"" help:"Settings for the filter as a JSON object." name:"settings-json" placeholder:"JSON"`
+}
+
+// Run executes the command to create a filter on a source.
+func (cmd *FilterCreateCmd) Run(ctx *context) error {
+	var settings map[string]any
+	if cmd.SettingsJSON != "" {
+		if err := decodeJSON([]byte(cmd.SettingsJSON), &settings); err != nil {
+			return fmt.Errorf("failed to parse settings JSON: %w", err)
+		}
+	}
+
+	resp, err := ctx.Client.Filters.GetSourceFilterKindList()
+	if err != nil {
+		return fmt.Errorf("failed to get filter kinds: %w", err)
+	}
+	cmd.Kind, err = resolveName(ctx, "filter kind", cmd.Kind, resp.SourceFilterKinds)
+	if err != nil {
+		return err
+	}
+
+	params := filters.NewCreateSourceFilterParams().
+		WithFilterName(cmd.FilterName).
+		WithFilterKind(cmd.Kind)
+	if settings != nil {
+		params = params.WithFilterSettings(settings)
+	}
+	params = withSource(params, cmd.SourceName)
+	_, err = ctx.Client.Filters.CreateSourceFilter(params)
+	if err != nil {
+		return fmt.Errorf("failed to create filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Filter %s (%s) created on source %s.\n",
+		ctx.Style.Highlight(cmd.FilterName), cmd.Kind, ctx.Style.Highlight(cmd.SourceName))
+	return nil
+}
+
+// FilterRemoveCmd provides a command to remove a filter from a source.
+type FilterRemoveCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source to remove the filter from."`
+	FilterName string `arg:"" help:"Name of the filter to remove."`
+}
+
+// Run executes the command to remove a filter from a source.
+func (cmd *FilterRemoveCmd) Run(ctx *context) error {
+	// Fuzzy matching is not applied here, a filter is only removed by its exact name
+	filterName, err := resolveFilterName(exactNames(ctx), cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewRemoveSourceFilterParams().WithFilterName(cmd.FilterName)
+	params = withSource(params, cmd.SourceName)
+	_, err = ctx.Client.Filters.RemoveSourceFilter(params)
+	if err != nil {
+		return fmt.Errorf("failed to remove filter %s from source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Filter %s removed from source %s.\n",
+		ctx.Style.Highlight(cmd.FilterName), ctx.Style.Highlight(cmd.SourceName))
+	return nil
+}
+
+// FilterRenameCmd provides a command to rename a filter on a source.
+type FilterRenameCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source of the filter."`
+	FilterName string `arg:"" help:"Name of the filter to rename."`
+	NewName    string `arg:"" help:"New name for the filter."`
+}
+
+// Run executes the command to rename a filter on a source.
+func (cmd *FilterRenameCmd) Run(ctx *context) error {
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewSetSourceFilterNameParams().
+		WithFilterName(cmd.FilterName).
+		WithNewFilterName(cmd.NewName)
+	params = withSource(params, cmd.SourceName)
+	_, err = ctx.Client.Filters.SetSourceFilterName(params)
+	if err != nil {
+		return fmt.Errorf("failed to rename filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Filter %s on source %s renamed to %s.\n",
+		ctx.Style.Highlight(cmd.FilterName),
+		ctx.Style.Highlight(cmd.SourceName),
+		ctx.Style.Highlight(cmd.NewName))
+	return nil
+}
+
+// FilterSettingsCmd provides commands to get and set filter settings.
+type FilterSettingsCmd struct {
+	Get FilterSettingsGetCmd `cmd:"" help:"Get filter settings as JSON." aliases:"g" completion-enabled-command-alias:"false"`
+	Set FilterSettingsSetCmd `cmd:"" help:"Set filter settings."         aliases:"s" completion-enabled-command-alias:"false"`
+}
+
+// FilterSettingsGetCmd provides a command to get the settings of a filter.
+type FilterSettingsGetCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source of the filter."`
+	FilterName string `arg:"" help:"Name of the filter."`
+}
+
+// Run executes the command to get the settings of a filter.
+func (cmd *FilterSettingsGetCmd) Run(ctx *context) error {
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewGetSourceFilterParams().WithFilterName(cmd.FilterName)
+	params = withSource(params, cmd.SourceName)
+	filter, err := ctx.Client.Filters.GetSourceFilter(params)
+	if err != nil {
+		return fmt.Errorf("failed to get filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	data, err := json.MarshalIndent(filter.FilterSettings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal filter settings: %w", err)
+	}
+	fmt.Fprintln(ctx.Out, string(data))
+	return nil
+}
+
+// FilterSettingsSetCmd provides a command to set the settings of a filter.
+type FilterSettingsSetCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source of the filter."`
+	FilterName string `arg:"" help:"Name of the filter."`
+
+	SettingsFlags `embed:""`
+}
+
+// Run executes the command to set the settings of a filter.
+func (cmd *FilterSettingsSetCmd) Run(ctx *context) error {
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	n, err := cmd.apply(func(settings map[string]any, overlay bool) error {
+		params := filters.NewSetSourceFilterSettingsParams().
+			WithFilterName(cmd.FilterName).
+			WithFilterSettings(settings).
+			WithOverlay(overlay)
+		params = withSource(params, cmd.SourceName)
+		_, err := ctx.Client.Filters.SetSourceFilterSettings(params)
+		if err != nil {
+			return fmt.Errorf("failed to set settings of filter %s on source %s: %w",
+				ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Out, "Updated %d settings of filter %s on source %s.\n",
+		n, ctx.Style.Highlight(cmd.FilterName), ctx.Style.Highlight(cmd.SourceName))
+	return nil
+}
+
+// FilterKindsCmd provides a command to list the available filter kinds.
+type FilterKindsCmd struct{}
+
+// Run executes the command to list the available filter kinds.
+func (cmd *FilterKindsCmd) Run(ctx *context) error {
+	resp, err := ctx.Client.Filters.GetSourceFilterKindList()
+	if err != nil {
+		return fmt.Errorf("failed to get filter kinds: %w", err)
+	}
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border))
+	t.Headers("Kind")
+	t.StyleFunc(func(row, col int) lipgloss.Style {
+		style := lipgloss.NewStyle().Padding(0, 3)
+		if col == 0 {
+			style = style.Align(lipgloss.Left)
+		}
+		switch {
+		case row == table.HeaderRow:
+			style = style.Bold(true).Align(lipgloss.Center)
+		case row%2 == 0:
+			style = style.Foreground(ctx.Style.evenRows)
+		default:
+			style = style.Foreground(ctx.Style.oddRows)
+		}
+		return style
+	})
+
+	kinds := resp.SourceFilterKinds
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		t.Row(kind)
+	}
+
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// FilterOrderCmd provides a command to change the position of a filter on a source.
+type FilterOrderCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source of the filter."`
+	FilterName string `arg:"" help:"Name of the filter to move."`
+
+	Index *int `flag:"" help:"New index of the filter, 0 is the first filter applied." xor:"position" required:""`
+	Up    bool `flag:"" help:"Move the filter up one position."                        xor:"position" required:""`
+	Down  bool `flag:"" help:"Move the filter down one position."                      xor:"position" required:""`
+}
+
+// Run executes the command to change the position of a filter on a source.
+func (cmd *FilterOrderCmd) Run(ctx *context) error {
+	sourceFilters, err := listFilters(ctx, cmd.SourceName)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(sourceFilters))
+	for _, filter := range sourceFilters {
+		names = append(names, filter.FilterName)
+	}
+	cmd.FilterName, err = resolveName(ctx, "filter", cmd.FilterName, names)
+	if err != nil {
+		return err
+	}
+	current := slices.Index(names, cmd.FilterName)
+
+	var index int
+	switch {
+	case cmd.Index != nil:
+		index = *cmd.Index
+	case cmd.Up:
+		index = current - 1
+	case cmd.Down:
+		index = current + 1
+	}
+	if index < 0 || index >= len(names) {
+		return fmt.Errorf("cannot move filter %s to index %d, source %s has %d filters",
+			ctx.Style.Error(cmd.FilterName), index, ctx.Style.Error(cmd.SourceName), len(names))
+	}
+
+	params := filters.NewSetSourceFilterIndexParams().
+		WithFilterName(cmd.FilterName).
+		WithFilterIndex(index)
+	params = withSource(params, cmd.SourceName)
+	_, err = ctx.Client.Filters.SetSourceFilterIndex(params)
+	if err != nil {
+		return fmt.Errorf("failed to move filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Filter %s on source %s moved to index %d.\n",
+		ctx.Style.Highlight(cmd.FilterName), ctx.Style.Highlight(cmd.SourceName), index)
+	return nil
+}
+
+// FilterCopyCmd provides a command to copy the filters of one source to another.
+type FilterCopyCmd struct {
+	FromSource string `arg:"" help:"Name or UUID of the source to copy filters from."`
+	ToSource   string `arg:"" help:"Name or UUID of the source to copy filters to."`
+
+	Replace bool `flag:"" help:"Replace the existing filters on the target source."`
+}
+
+// Run executes the command to copy the filters of one source to another.
+func (cmd *FilterCopyCmd) Run(ctx *context) error {
+	sourceFilters, err := listFilters(ctx, cmd.FromSource)
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(sourceFilters, func(a, b *typedefs.Filter) int {
+		return a.FilterIndex - b.FilterIndex
+	})
+	if err := createFilters(ctx, cmd.ToSource, sourceFilters, cmd.Replace); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Out, "Copied %d filters from source %s to source %s.\n",
+		len(sourceFilters), ctx.Style.Highlight(cmd.FromSource), ctx.Style.Highlight(cmd.ToSource))
+	return nil
+}
+
+// filterChainEntry is the portable representation of a filter used by filter export and import.
+type filterChainEntry struct {
+	Name     string         `json:"name"`
+	Kind     string         `json:"kind"`
+	Enabled  bool           `json:"enabled"`
+	Settings map[string]any `json:"settings"`
+}
+
+// FilterExportCmd provides a command to export the filters of a source as JSON.
+type FilterExportCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source to export filters from."`
+}
+
+// Run executes the command to export the filters of a source as JSON.
+func (cmd *FilterExportCmd) Run(ctx *context) error {
+	sourceFilters, err := listFilters(ctx, cmd.SourceName)
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(sourceFilters, func(a, b *typedefs.Filter) int {
+		return a.FilterIndex - b.FilterIndex
+	})
+
+	chain := make([]filterChainEntry, 0, len(sourceFilters))
+	for _, filter := range sourceFilters {
+		settings := filter.FilterSettings
+		if settings == nil {
+			settings = map[string]any{}
+		}
+		chain = append(chain, filterChainEntry{
+			Name:     filter.FilterName,
+			Kind:     filter.FilterKind,
+			Enabled:  filter.FilterEnabled,
+			Settings: settings,
+		})
+	}
+
+	data, err := json.MarshalIndent(chain, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal filters: %w", err)
+	}
+	fmt.Fprintln(ctx.Out, string(data))
+	return nil
+}
+
+// FilterImportCmd provides a command to import filters exported with filter export.
+type FilterImportCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source to import filters to."`
+	Path       string `arg:"" help:"Path to the exported filters, - for stdin."`
+
+	Replace bool `flag:"" help:"Replace the existing filters on the source."`
+}
+
+// Run executes the command to import filters exported with filter export.
+func (cmd *FilterImportCmd) Run(ctx *context) error {
+	data, err := readFileOrStdin(cmd.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read filters: %w", err)
+	}
+	var chain []filterChainEntry
+	if err := decodeJSON(data, &chain); err != nil {
+		return fmt.Errorf("failed to parse filters from %s: %w", cmd.Path, err)
+	}
+
+	sourceFilters := make([]*typedefs.Filter, 0, len(chain))
+	for i, entry := range chain {
+		if entry.Name == "" || entry.Kind == "" {
+			return fmt.Errorf("filter %d in %s is missing a name or kind", i, cmd.Path)
+		}
+		sourceFilters = append(sourceFilters, &typedefs.Filter{
+			FilterName:     entry.Name,
+			FilterKind:     entry.Kind,
+			FilterEnabled:  entry.Enabled,
+			FilterSettings: entry.Settings,
+		})
+	}
+	if err := createFilters(ctx, cmd.SourceName, sourceFilters, cmd.Replace); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Out, "Imported %d filters to source %s.\n",
+		len(sourceFilters), ctx.Style.Highlight(cmd.SourceName))
+	return nil
+}
+
+// filterAnimateInterval is the delay between updates of an animated filter setting.
+const filterAnimateInterval = 50 * time.Millisecond
+
+// FilterAnimateCmd provides a command to animate a numeric filter setting to a target value.
+type FilterAnimateCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source of the filter."`
+	FilterName string `arg:"" help:"Name of the filter."`
+	Setting    string `arg:"" help:"Name of the numeric setting to animate (e.g., db, opacity)."`
+
+	To       numberValue   `flag:"" help:"Target value of the setting."                               required:"" placeholder:"VALUE"`
+	From     *numberValue  `flag:"" help:"Start value of the setting, defaults to the current value."             placeholder:"VALUE"`
+	Duration time.Duration `flag:"" help:"Duration of the animation."                                                                 default:"1s"`
+	Easing   string        `flag:"" help:"Easing function of the animation."                                                          default:"linear" enum:"linear,ease-in,ease-out,ease-in-out"`
+}
+
+// Run executes the command to animate a numeric filter setting.
+func (cmd *FilterAnimateCmd) Run(ctx *context) error {
+	if cmd.Duration < 0 {
+		return fmt.Errorf("duration must not be negative")
+	}
+
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	getParams := filters.NewGetSourceFilterParams().WithFilterName(cmd.FilterName)
+	getParams = withSource(getParams, cmd.SourceName)
+	filter, err := ctx.Client.Filters.GetSourceFilter(getParams)
+	if err != nil {
+		return fmt.Errorf("failed to get filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	var from float64
+	if cmd.From != nil {
+		from = float64(*cmd.From)
+	} else {
+		// Settings left at their default are not part of the filter settings
+		defaultSettings, err := ctx.Client.Filters.GetSourceFilterDefaultSettings(
+			filters.NewGetSourceFilterDefaultSettingsParams().
+				WithFilterKind(filter.FilterKind),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get default settings for filter %s: %w",
+				ctx.Style.Error(cmd.FilterName), err)
+		}
+		value, ok := filter.FilterSettings[cmd.Setting]
+		if !ok {
+			value, ok = defaultSettings.DefaultFilterSettings[cmd.Setting]
+		}
+		if !ok {
+			return fmt.Errorf("filter %s has no setting %s",
+				ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.Setting))
+		}
+		from, ok = value.(float64)
+		if !ok {
+			return fmt.Errorf("setting %s of filter %s is not numeric",
+				ctx.Style.Error(cmd.Setting), ctx.Style.Error(cmd.FilterName))
+		}
+	}
+
+	err = animate(cmd.Duration, filterAnimateInterval, cmd.Easing, func(t float64) error {
+		params := filters.NewSetSourceFilterSettingsParams().
+			WithFilterName(cmd.FilterName).
+			WithFilterSettings(map[string]any{cmd.Setting: lerp(from, float64(cmd.To), t)}).
+			WithOverlay(true)
+		params = withSource(params, cmd.SourceName)
+		_, err := ctx.Client.Filters.SetSourceFilterSettings(params)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set settings of filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Animated %s of filter %s on source %s from %g to %g.\n",
+		cmd.Setting, ctx.Style.Highlight(cmd.FilterName), ctx.Style.Highlight(cmd.SourceName),
+		from, cmd.To)
+	return nil
+}
+
+// filterSettingJSON is the JSON representation of a setting shown by filter show.
+type filterSettingJSON struct {
+	Current any  `json:"current"`
+	Default any  `json:"default"`
+	Changed bool `json:"changed"`
+}
+
+// FilterShowCmd provides a command to show the settings of a filter alongside their defaults.
+type FilterShowCmd struct {
+	SourceName string `arg:"" help:"Name or UUID of the source of the filter."`
+	FilterName string `arg:"" help:"Name of the filter."`
+
+	JSON bool `flag:"" help:"Output the filter as JSON."`
+}
+
+// Run executes the command to show the settings of a filter.
+// nolint: misspell
+func (cmd *FilterShowCmd) Run(ctx *context) error {
+	filterName, err := resolveFilterName(ctx, cmd.SourceName, cmd.FilterName)
+	if err != nil {
+		return err
+	}
+	cmd.FilterName = filterName
+
+	params := filters.NewGetSourceFilterParams().WithFilterName(cmd.FilterName)
+	params = withSource(params, cmd.SourceName)
+	filter, err := ctx.Client.Filters.GetSourceFilter(params)
+	if err != nil {
+		return fmt.Errorf("failed to get filter %s on source %s: %w",
+			ctx.Style.Error(cmd.FilterName), ctx.Style.Error(cmd.SourceName), err)
+	}
+	defaults, err := filterDefaultSettings(ctx, filter.FilterKind)
+	if err != nil {
+		return err
+	}
+
+	settings := make(map[string]filterSettingJSON)
+	for key, value := range defaults {
+		settings[key] = filterSettingJSON{Current: value, Default: value}
+	}
+	for key, value := range filter.FilterSettings {
+		settings[key] = filterSettingJSON{
+			Current: value,
+			Default: defaults[key],
+			Changed: formatSettingValue(value) != formatSettingValue(defaults[key]),
+		}
+	}
+
+	if cmd.JSON {
+		data, err := json.MarshalIndent(struct {
+			Name     string                       `json:"name"`
+			Kind     string                       `json:"kind"`
+			Index    int                          `json:"index"`
+			Enabled  bool                         `json:"enabled"`
+			Settings map[string]filterSettingJSON `json:"settings"`
+		}{cmd.FilterName, filter.FilterKind, filter.FilterIndex, filter.FilterEnabled, settings}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal filter: %w", err)
+		}
+		fmt.Fprintln(ctx.Out, string(data))
+		return nil
+	}
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border)).
+		Headers("Setting", "Current", "Default", "Changed").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 3)
+			switch col {
+			case 0:
+				style = style.Align(lipgloss.Left)
+			case 1:
+				style = style.Align(lipgloss.Left)
+			case 2:
+				style = style.Align(lipgloss.Left)
+			case 3:
+				style = style.Align(lipgloss.Center)
+			}
+			switch {
+			case row == table.HeaderRow:
+				style = style.Bold(true).Align(lipgloss.Center)
+			case row%2 == 0:
+				style = style.Foreground(ctx.Style.evenRows)
+			default:
+				style = style.Foreground(ctx.Style.oddRows)
+			}
+			return style
+		})
+
+	for _, key := range slices.Sorted(maps.Keys(settings)) {
+		setting := settings[key]
+		var defaultValue string
+		if setting.Default != nil {
+			defaultValue = formatSettingValue(setting.Default)
+		}
+		t.Row(
+			key,
+			formatSettingValue(setting.Current),
+			defaultValue,
+			getEnabledMark(setting.Changed),
+		)
+	}
+
+	status := "disabled"
+	if filter.FilterEnabled {
+		status = "enabled"
+	}
+	fmt.Fprintf(ctx.Out, "Filter %s (%s) on source %s, index %d, %s.\n",
+		ctx.Style.Highlight(cmd.FilterName),
+		filter.FilterKind,
+		ctx.Style.Highlight(cmd.SourceName),
+		filter.FilterIndex,
+		status,
+	)
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// filterDifference is a single difference between two filter chains.
+// An empty value means the filter or setting does not exist on that source.
+type filterDifference struct {
+	filter  string
+	setting string
+	a, b    string
+}
+
+// FilterDiffCmd provides a command to compare the filters of two sources.
+type FilterDiffCmd struct {
+	SourceA string `arg:"" help:"Name or UUID of the first source."`
+	SourceB string `arg:"" help:"Name or UUID of the second source."`
+}
+
+// Run executes the command to compare the filters of two sources.
+func (cmd *FilterDiffCmd) Run(ctx *context) error {
+	chains := make([][]*typedefs.Filter, 2)
+	defaults := make(map[string]map[string]any)
+	for i, sourceName := range []string{cmd.SourceA, cmd.SourceB} {
+		sourceFilters, err := listFilters(ctx, sourceName)
+		if err != nil {
+			return err
+		}
+		// Compare effective values, settings left at their default are not part of the filter settings
+		for _, filter := range sourceFilters {
+			if _, ok := defaults[filter.FilterKind]; !ok {
+				defaults[filter.FilterKind], err = filterDefaultSettings(ctx, filter.FilterKind)
+				if err != nil {
+					return err
+				}
+			}
+			settings := maps.Clone(defaults[filter.FilterKind])
+			if settings == nil {
+				settings = make(map[string]any)
+			}
+			maps.Copy(settings, filter.FilterSettings)
+			filter.FilterSettings = settings
+		}
+		chains[i] = sourceFilters
+	}
+
+	differences := diffFilterChains(chains[0], chains[1])
+	if len(differences) == 0 {
+		fmt.Fprintf(ctx.Out, "Filters of sources %s and %s are identical.\n",
+			ctx.Style.Highlight(cmd.SourceA), ctx.Style.Highlight(cmd.SourceB))
+		return nil
+	}
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border)).
+		Headers("Filter", "Setting", cmd.SourceA, cmd.SourceB).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 3).Align(lipgloss.Left)
+			switch {
+			case row == table.HeaderRow:
+				style = style.Bold(true).Align(lipgloss.Center)
+			case row%2 == 0:
+				style = style.Foreground(ctx.Style.evenRows)
+			default:
+				style = style.Foreground(ctx.Style.oddRows)
+			}
+			return style
+		})
+
+	for _, d := range differences {
+		t.Row(d.filter, d.setting, d.a, d.b)
+	}
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// diffFilterChains compares two filter chains by filter name.
+// A filter missing from one chain is reported once under "kind", otherwise
+// differences in kind, index, enabled state and settings are reported.
+func diffFilterChains(a, b []*typedefs.Filter) []filterDifference {
+	byName := func(chain []*typedefs.Filter) map[string]*typedefs.Filter {
+		m := make(map[string]*typedefs.Filter, len(chain))
+		for _, filter := range chain {
+			m[filter.FilterName] = filter
+		}
+		return m
+	}
+	inA, inB := byName(a), byName(b)
+
+	var names []string
+	for _, chain := range [][]*typedefs.Filter{a, b} {
+		sorted := slices.Clone(chain)
+		slices.SortFunc(sorted, func(x, y *typedefs.Filter) int {
+			return x.FilterIndex - y.FilterIndex
+		})
+		for _, filter := range sorted {
+			if !slices.Contains(names, filter.FilterName) {
+				names = append(names, filter.FilterName)
+			}
+		}
+	}
+
+	var differences []filterDifference
+	for _, name := range names {
+		fa, okA := inA[name]
+		fb, okB := inB[name]
+		switch {
+		case !okB:
+			differences = append(differences, filterDifference{name, "kind", fa.FilterKind, ""})
+			continue
+		case !okA:
+			differences = append(differences, filterDifference{name, "kind", "", fb.FilterKind})
+			continue
+		case fa.FilterKind != fb.FilterKind:
+			differences = append(differences, filterDifference{name, "kind", fa.FilterKind, fb.FilterKind})
+			continue
+		}
+
+		if fa.FilterIndex != fb.FilterIndex {
+			differences = append(differences, filterDifference{
+				name, "index", fmt.Sprintf("%d", fa.FilterIndex), fmt.Sprintf("%d", fb.FilterIndex),
+			})
+		}
+		if fa.FilterEnabled != fb.FilterEnabled {
+			differences = append(differences, filterDifference{
+				name, "enabled", fmt.Sprintf("%t", fa.FilterEnabled), fmt.Sprintf("%t", fb.FilterEnabled),
+			})
+		}
+
+		keys := slices.Collect(maps.Keys(fa.FilterSettings))
+		for key := range fb.FilterSettings {
+			if _, ok := fa.FilterSettings[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			var va, vb string
+			if value, ok := fa.FilterSettings[key]; ok {
+				va = formatSettingValue(value)
+			}
+			if value, ok := fb.FilterSettings[key]; ok {
+				vb = formatSettingValue(value)
+			}
+			if va != vb {
+				differences = append(differences, filterDifference{name, key, va, vb})
+			}
+		}
+	}
+	return differences
+}
+
+// filterDefaultSettings returns the default settings of a filter kind.
+func filterDefaultSettings(ctx *context, kind string) (map[string]any, error) {
+	resp, err := ctx.Client.Filters.GetSourceFilterDefaultSettings(
+		filters.NewGetSourceFilterDefaultSettingsParams().WithFilterKind(kind),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default settings for filter kind %s: %w",
+			ctx.Style.Error(kind), err)
+	}
+	return resp.DefaultFilterSettings, nil
+}
+
+// createFilters appends sourceFilters, in order, to the filter chain of the source.
+// If replace is set the existing filters are replaced, otherwise a name clash is an error.
+// The kinds and names of the filters are validated before the source is changed.
+func createFilters(ctx *context, sourceName string, sourceFilters []*typedefs.Filter, replace bool) error {
+	targetFilters, err := listFilters(ctx, sourceName)
+	if err != nil {
+		return err
+	}
+	if err := validateFilters(ctx, sourceFilters); err != nil {
+		return err
+	}
+
+	if !replace {
+		for _, filter := range sourceFilters {
+			if slices.ContainsFunc(targetFilters, func(f *typedefs.Filter) bool {
+				return f.FilterName == filter.FilterName
+			}) {
+				return fmt.Errorf("filter %s already exists on source %s, use --replace to overwrite",
+					ctx.Style.Error(filter.FilterName), ctx.Style.Error(sourceName))
+			}
+		}
+		for _, filter := range sourceFilters {
+			if err := createFilter(ctx, sourceName, filter); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Create the filters under temporary names before removing the existing ones,
+	// so the source keeps its filters if OBS rejects any of them
+	taken := make([]string, 0, len(targetFilters)+len(sourceFilters))
+	for _, filter := range targetFilters {
+		taken = append(taken, filter.FilterName)
+	}
+	for _, filter := range sourceFilters {
+		taken = append(taken, filter.FilterName)
+	}
+	tempNames := make([]string, 0, len(sourceFilters))
+	for _, filter := range sourceFilters {
+		temp := *filter
+		temp.FilterName = uniqueFilterName(filter.FilterName, taken)
+		taken = append(taken, temp.FilterName)
+		if err := createFilter(ctx, sourceName, &temp); err != nil {
+			return errors.Join(err, removeFilters(ctx, sourceName, tempNames))
+		}
+		tempNames = append(tempNames, temp.FilterName)
+	}
+
+	targetNames := make([]string, 0, len(targetFilters))
+	for _, filter := range targetFilters {
+		targetNames = append(targetNames, filter.FilterName)
+	}
+	if err := removeFilters(ctx, sourceName, targetNames); err != nil {
+		return err
+	}
+
+	for i, filter := range sourceFilters {
+		params := filters.NewSetSourceFilterNameParams().
+			WithFilterName(tempNames[i]).
+			WithNewFilterName(filter.FilterName)
+		params = withSource(params, sourceName)
+		_, err := ctx.Client.Filters.SetSourceFilterName(params)
+		if err != nil {
+			return fmt.Errorf("failed to rename filter %s on source %s to %s: %w",
+				ctx.Style.Error(tempNames[i]), ctx.Style.Error(sourceName), ctx.Style.Error(filter.FilterName), err)
+		}
+	}
+	return nil
+}
+
+// validateFilters checks that every filter has a name unique among filters and a known kind.
+func validateFilters(ctx *context, sourceFilters []*typedefs.Filter) error {
+	resp, err := ctx.Client.Filters.GetSourceFilterKindList()
+	if err != nil {
+		return fmt.Errorf("failed to get filter kinds: %w", err)
+	}
+
+	seen := make(map[string]bool, len(sourceFilters))
+	for _, filter := range sourceFilters {
+		if filter.FilterName == "" {
+			return errors.New("filter names must not be empty")
+		}
+		if seen[filter.FilterName] {
+			return fmt.Errorf("filter %s is given more than once", ctx.Style.Error(filter.FilterName))
+		}
+		seen[filter.FilterName] = true
+
+		if !slices.Contains(resp.SourceFilterKinds, filter.FilterKind) {
+			return fmt.Errorf("filter %s has unknown kind %s",
+				ctx.Style.Error(filter.FilterName), ctx.Style.Error(filter.FilterKind))
+		}
+	}
+	return nil
+}
+
+// uniqueFilterName returns a temporary name based on name which is not in taken.
+func uniqueFilterName(name string, taken []string) string {
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s (%d)", name, i)
+		if !slices.Contains(taken, candidate) {
+			return candidate
+		}
+	}
+}
+
+// removeFilters removes the named filters from the source.
+func removeFilters(ctx *context, sourceName string, names []string) error {
+	for _, name := range names {
+		params := filters.NewRemoveSourceFilterParams().WithFilterName(name)
+		params = withSource(params, sourceName)
+		_, err := ctx.Client.Filters.RemoveSourceFilter(params)
+		if err != nil {
+			return fmt.Errorf("failed to remove filter %s from source %s: %w",
+				ctx.Style.Error(name), ctx.Style.Error(sourceName), err)
+		}
+	}
+	return nil
+}
+
+// createFilter creates a filter on the source with the kind, settings and enabled state of filter.
+// The filter is appended to the end of the source's filter chain.
+func createFilter(ctx *context, sourceName string, filter *typedefs.Filter) error {
+	createParams := filters.NewCreateSourceFilterParams().
+		WithFilterName(filter.FilterName).
+		WithFilterKind(filter.FilterKind).
+		WithFilterSettings(filter.FilterSettings)
+	enabledParams := filters.NewSetSourceFilterEnabledParams().
+		WithFilterName(filter.FilterName).
+		WithFilterEnabled(filter.FilterEnabled)
+	createParams = withSource(createParams, sourceName)
+	enabledParams = withSource(enabledParams, sourceName)
+
+	_, err := ctx.Client.Filters.CreateSourceFilter(createParams)
+	if err != nil {
+		return fmt.Errorf("failed to create filter %s on source %s: %w",
+			ctx.Style.Error(filter.FilterName), ctx.Style.Error(sourceName), err)
+	}
+	if !filter.FilterEnabled {
+		_, err = ctx.Client.Filters.SetSourceFilterEnabled(enabledParams)
+		if err != nil {
+			return fmt.Errorf("failed to disable filter %s on source %s: %w",
+				ctx.Style.Error(filter.FilterName), ctx.Style.Error(sourceName), err)
+		}
+	}
+	return nil
+}
+
+// listFilters returns the filters of the source given by name or UUID.
+func listFilters(ctx *context, sourceName string) ([]*typedefs.Filter, error) {
+	params := filters.NewGetSourceFilterListParams()
+	params = withSource(params, sourceName)
+	resp, err := ctx.Client.Filters.GetSourceFilterList(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Filters, nil
+}
+
+// resolveFilterName returns the name of a filter on the source given by name or UUID.
+// Unknown names are resolved as described in resolveName.
+func resolveFilterName(ctx *context, sourceName string, filterName string) (string, error) {
+	sourceFilters, err := listFilters(ctx, sourceName)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(sourceFilters))
+	for _, filter := range sourceFilters {
+		names = append(names, filter.FilterName)
+	}
+	return resolveName(ctx, "filter", filterName, names)
+}
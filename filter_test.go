@@ -2,8 +2,16 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/andreykaipov/goobs/api/typedefs"
 )
 
 func TestFilterList(t *testing.T) {
@@ -68,3 +76,497 @@ func TestFilterListEmpty(t *testing.T) {
 		)
 	}
 }
+
+func TestFilterCreateRenameRemove(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdCreate := &FilterCreateCmd{
+		SourceName:   "Mic/Aux",
+		FilterName:   "test_gain",
+		Kind:         "gain_filter",
+		SettingsJSON: `{"db": 6}`,
+	}
+	err := cmdCreate.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to create filter: %v", err)
+	}
+	if out.String() != "Filter test_gain (gain_filter) created on source Mic/Aux.\n" {
+		t.Fatalf(
+			"Expected output to be 'Filter test_gain (gain_filter) created on source Mic/Aux.', got '%s'",
+			out.String(),
+		)
+	}
+	out.Reset()
+
+	cmdRename := &FilterRenameCmd{
+		SourceName: "Mic/Aux",
+		FilterName: "test_gain",
+		NewName:    "test_gain_renamed",
+	}
+	err = cmdRename.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to rename filter: %v", err)
+	}
+	if out.String() != "Filter test_gain on source Mic/Aux renamed to test_gain_renamed.\n" {
+		t.Fatalf(
+			"Expected output to be 'Filter test_gain on source Mic/Aux renamed to test_gain_renamed.', got '%s'",
+			out.String(),
+		)
+	}
+	out.Reset()
+
+	cmdRemove := &FilterRemoveCmd{
+		SourceName: "Mic/Aux",
+		FilterName: "test_gain_renamed",
+	}
+	err = cmdRemove.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to remove filter: %v", err)
+	}
+	if out.String() != "Filter test_gain_renamed removed from source Mic/Aux.\n" {
+		t.Fatalf(
+			"Expected output to be 'Filter test_gain_renamed removed from source Mic/Aux.', got '%s'",
+			out.String(),
+		)
+	}
+}
+
+func TestFilterSettings(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdSet := &FilterSettingsSetCmd{
+		SourceName: "gobs-test-scene",
+		FilterName: "test_filter",
+		SettingsFlags: SettingsFlags{
+			Settings: []string{"luma=0.25"},
+			Overlay:  true,
+		},
+	}
+	err := cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to set filter settings: %v", err)
+	}
+	out.Reset()
+
+	cmdGet := &FilterSettingsGetCmd{
+		SourceName: "gobs-test-scene",
+		FilterName: "test_filter",
+	}
+	err = cmdGet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get filter settings: %v", err)
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(out.Bytes(), &settings); err != nil {
+		t.Fatalf("Expected output to be JSON, got '%s'", out.String())
+	}
+	if settings["luma"] != 0.25 {
+		t.Fatalf("Expected luma to be 0.25, got %v", settings["luma"])
+	}
+
+	cmdSet.Settings = []string{"luma=0.5"}
+	err = cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore filter settings: %v", err)
+	}
+}
+
+func TestFilterKinds(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &FilterKindsCmd{}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to list filter kinds: %v", err)
+	}
+	if !strings.Contains(out.String(), "gain_filter") {
+		t.Fatalf("Expected output to contain 'gain_filter', got '%s'", out.String())
+	}
+}
+
+func TestFilterOrder(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdCreate := &FilterCreateCmd{
+		SourceName: "Mic/Aux",
+		FilterName: "test_order",
+		Kind:       "gain_filter",
+	}
+	err := cmdCreate.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to create filter: %v", err)
+	}
+	defer func() {
+		cmdRemove := &FilterRemoveCmd{
+			SourceName: "Mic/Aux",
+			FilterName: "test_order",
+		}
+		if err := cmdRemove.Run(context); err != nil {
+			t.Fatalf("Failed to remove filter: %v", err)
+		}
+	}()
+	out.Reset()
+
+	index := 0
+	cmdOrder := &FilterOrderCmd{
+		SourceName: "Mic/Aux",
+		FilterName: "test_order",
+		Index:      &index,
+	}
+	err = cmdOrder.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to order filter: %v", err)
+	}
+	if out.String() != "Filter test_order on source Mic/Aux moved to index 0.\n" {
+		t.Fatalf(
+			"Expected output to be 'Filter test_order on source Mic/Aux moved to index 0.', got '%s'",
+			out.String(),
+		)
+	}
+	out.Reset()
+
+	cmdOrder = &FilterOrderCmd{
+		SourceName: "Mic/Aux",
+		FilterName: "test_order",
+		Down:       true,
+	}
+	err = cmdOrder.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to order filter: %v", err)
+	}
+	if out.String() != "Filter test_order on source Mic/Aux moved to index 1.\n" {
+		t.Fatalf(
+			"Expected output to be 'Filter test_order on source Mic/Aux moved to index 1.', got '%s'",
+			out.String(),
+		)
+	}
+}
+
+func TestFilterCopy(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdCopy := &FilterCopyCmd{
+		FromSource: "gobs-test-scene",
+		ToSource:   "gobs-test-input",
+	}
+	err := cmdCopy.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to copy filters: %v", err)
+	}
+	defer func() {
+		cmdRemove := &FilterRemoveCmd{
+			SourceName: "gobs-test-input",
+			FilterName: "test_filter",
+		}
+		if err := cmdRemove.Run(context); err != nil {
+			t.Fatalf("Failed to remove filter: %v", err)
+		}
+	}()
+	if out.String() != "Copied 1 filters from source gobs-test-scene to source gobs-test-input.\n" {
+		t.Fatalf(
+			"Expected output to be 'Copied 1 filters from source gobs-test-scene to source gobs-test-input.', got '%s'",
+			out.String(),
+		)
+	}
+
+	err = cmdCopy.Run(context)
+	if err == nil {
+		t.Fatal("Expected error when copying an existing filter, but got none")
+	}
+	if !strings.Contains(err.Error(), "already exists on source gobs-test-input") {
+		t.Fatalf("Expected error to mention the existing filter, got '%s'", err.Error())
+	}
+
+	cmdCopy.Replace = true
+	err = cmdCopy.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to copy filters with --replace: %v", err)
+	}
+	targetFilters, err := listFilters(context, "gobs-test-input")
+	if err != nil {
+		t.Fatalf("Failed to list filters: %v", err)
+	}
+	if len(targetFilters) != 1 || targetFilters[0].FilterName != "test_filter" {
+		t.Fatalf("Expected a single filter named 'test_filter' after replacing, got %+v", targetFilters)
+	}
+}
+
+func TestFilterImportReplaceInvalid(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	path := filepath.Join(t.TempDir(), "chain.json")
+	chain := `[{"name": "broken", "kind": "not_a_filter", "enabled": true, "settings": {}}]`
+	if err := os.WriteFile(path, []byte(chain), 0o600); err != nil {
+		t.Fatalf("Failed to write filters: %v", err)
+	}
+
+	cmdImport := &FilterImportCmd{
+		SourceName: "gobs-test-scene",
+		Path:       path,
+		Replace:    true,
+	}
+	err := cmdImport.Run(context)
+	if err == nil {
+		t.Fatal("Expected an error importing a filter of an unknown kind")
+	}
+	if !strings.Contains(err.Error(), "unknown kind not_a_filter") {
+		t.Fatalf("Expected error to mention the unknown kind, got '%s'", err.Error())
+	}
+
+	sourceFilters, err := listFilters(context, "gobs-test-scene")
+	if err != nil {
+		t.Fatalf("Failed to list filters: %v", err)
+	}
+	if len(sourceFilters) != 1 || sourceFilters[0].FilterName != "test_filter" {
+		t.Fatalf("Expected the existing filters to be kept, got %+v", sourceFilters)
+	}
+}
+
+func TestUniqueFilterName(t *testing.T) {
+	got := uniqueFilterName("Compressor", []string{"Compressor", "Compressor (1)"})
+	if got != "Compressor (2)" {
+		t.Errorf("Expected 'Compressor (2)' but got '%s'", got)
+	}
+}
+
+func TestFilterExportImport(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdExport := &FilterExportCmd{
+		SourceName: "gobs-test-scene",
+	}
+	err := cmdExport.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to export filters: %v", err)
+	}
+
+	var chain []filterChainEntry
+	if err := json.Unmarshal(out.Bytes(), &chain); err != nil {
+		t.Fatalf("Expected output to be JSON, got '%s'", out.String())
+	}
+	if len(chain) != 1 || chain[0].Name != "test_filter" || chain[0].Kind != "luma_key_filter_v2" {
+		t.Fatalf("Expected a single luma key filter named 'test_filter', got %+v", chain)
+	}
+
+	path := filepath.Join(t.TempDir(), "chain.json")
+	if err := os.WriteFile(path, out.Bytes(), 0o600); err != nil {
+		t.Fatalf("Failed to write exported filters: %v", err)
+	}
+	out.Reset()
+
+	cmdImport := &FilterImportCmd{
+		SourceName: "gobs-test-input",
+		Path:       path,
+	}
+	err = cmdImport.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to import filters: %v", err)
+	}
+	defer func() {
+		cmdRemove := &FilterRemoveCmd{
+			SourceName: "gobs-test-input",
+			FilterName: "test_filter",
+		}
+		if err := cmdRemove.Run(context); err != nil {
+			t.Fatalf("Failed to remove filter: %v", err)
+		}
+	}()
+	if out.String() != "Imported 1 filters to source gobs-test-input.\n" {
+		t.Fatalf(
+			"Expected output to be 'Imported 1 filters to source gobs-test-input.', got '%s'",
+			out.String(),
+		)
+	}
+}
+
+func TestFilterAnimate(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdAnimate := &FilterAnimateCmd{
+		SourceName: "gobs-test-scene",
+		FilterName: "test_filter",
+		Setting:    "luma",
+		To:         0.25,
+		Duration:   100 * time.Millisecond,
+		Easing:     "linear",
+	}
+	err := cmdAnimate.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to animate filter setting: %v", err)
+	}
+	if out.String() != "Animated luma of filter test_filter on source gobs-test-scene from 0.5 to 0.25.\n" {
+		t.Fatalf(
+			"Expected output to be 'Animated luma of filter test_filter on source gobs-test-scene from 0.5 to 0.25.', got '%s'",
+			out.String(),
+		)
+	}
+
+	cmdAnimate.To = 0.5
+	cmdAnimate.Duration = 0
+	err = cmdAnimate.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore filter setting: %v", err)
+	}
+}
+
+func TestFilterAnimateParseArgs(t *testing.T) {
+	var cli struct {
+		Animate FilterAnimateCmd `cmd:""`
+	}
+	parser, err := kong.New(&cli)
+	if err != nil {
+		t.Fatalf("Failed to create parser: %v", err)
+	}
+
+	_, err = parser.Parse([]string{"animate", "Mic/Aux", "Gain", "db", "--to", "-10", "--from", "-30"})
+	if err != nil {
+		t.Fatalf("Failed to parse animate: %v", err)
+	}
+	if cli.Animate.To != -10 || cli.Animate.From == nil || *cli.Animate.From != -30 {
+		t.Errorf("Expected --to -10 and --from -30, got %v and %v", cli.Animate.To, cli.Animate.From)
+	}
+	if cli.Animate.Setting != "db" {
+		t.Errorf("Expected setting db, got %s", cli.Animate.Setting)
+	}
+}
+
+func TestFilterShow(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &FilterShowCmd{
+		SourceName: "gobs-test-scene",
+		FilterName: "test_filter",
+		JSON:       true,
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to show filter: %v", err)
+	}
+
+	var filter struct {
+		Kind     string                       `json:"kind"`
+		Settings map[string]filterSettingJSON `json:"settings"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &filter); err != nil {
+		t.Fatalf("Expected output to be JSON, got '%s'", out.String())
+	}
+	if filter.Kind != "luma_key_filter_v2" {
+		t.Fatalf("Expected kind to be 'luma_key_filter_v2', got '%s'", filter.Kind)
+	}
+	if filter.Settings["luma"].Current != 0.5 {
+		t.Fatalf("Expected luma to be 0.5, got %v", filter.Settings["luma"].Current)
+	}
+}
+
+func TestFilterDiffIdentical(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &FilterDiffCmd{
+		SourceA: "Mic/Aux",
+		SourceB: "Mic/Aux",
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to diff filters: %v", err)
+	}
+	if out.String() != "Filters of sources Mic/Aux and Mic/Aux are identical.\n" {
+		t.Fatalf(
+			"Expected output to be 'Filters of sources Mic/Aux and Mic/Aux are identical.', got '%s'",
+			out.String(),
+		)
+	}
+}
+
+func TestDiffFilterChains(t *testing.T) {
+	gain := func(index int, enabled bool, db float64) *typedefs.Filter {
+		return &typedefs.Filter{
+			FilterName:     "Gain",
+			FilterKind:     "gain_filter",
+			FilterIndex:    index,
+			FilterEnabled:  enabled,
+			FilterSettings: map[string]any{"db": db},
+		}
+	}
+	limiter := &typedefs.Filter{
+		FilterName:     "Limiter",
+		FilterKind:     "limiter_filter",
+		FilterEnabled:  true,
+		FilterSettings: map[string]any{},
+	}
+
+	tests := []struct {
+		name     string
+		a, b     []*typedefs.Filter
+		expected []filterDifference
+	}{
+		{"Identical", []*typedefs.Filter{gain(0, true, 3)}, []*typedefs.Filter{gain(0, true, 3)}, nil},
+		{
+			"Setting",
+			[]*typedefs.Filter{gain(0, true, 3)},
+			[]*typedefs.Filter{gain(0, true, 6)},
+			[]filterDifference{{"Gain", "db", "3", "6"}},
+		},
+		{
+			"IndexAndEnabled",
+			[]*typedefs.Filter{gain(0, true, 3)},
+			[]*typedefs.Filter{gain(1, false, 3)},
+			[]filterDifference{{"Gain", "index", "0", "1"}, {"Gain", "enabled", "true", "false"}},
+		},
+		{
+			"Missing",
+			[]*typedefs.Filter{limiter},
+			[]*typedefs.Filter{gain(0, true, 3)},
+			[]filterDifference{{"Limiter", "kind", "limiter_filter", ""}, {"Gain", "kind", "", "gain_filter"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := diffFilterChains(test.a, test.b)
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the maximum number of "did you mean" suggestions offered for an unknown name.
+const maxSuggestions = 3
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// suggestNames returns the candidates closest to name, best match first.
+// Candidates containing name, or within a few edits of it, ignoring case, are considered close.
+func suggestNames(name string, candidates []string) []string {
+	lower := strings.ToLower(name)
+	threshold := max(2, len([]rune(name))/3)
+
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	var suggestions []suggestion
+	for _, candidate := range candidates {
+		distance := levenshtein(lower, strings.ToLower(candidate))
+		if distance <= threshold || strings.Contains(strings.ToLower(candidate), lower) {
+			suggestions = append(suggestions, suggestion{candidate, distance})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].distance < suggestions[j].distance
+	})
+
+	var names []string
+	for _, s := range suggestions[:min(len(suggestions), maxSuggestions)] {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+// fuzzyMatch returns the candidate matching name ignoring case or, failing that, the candidate
+// name is a case-insensitive prefix of. It reports false unless exactly one candidate matches.
+func fuzzyMatch(name string, candidates []string) (string, bool) {
+	for _, match := range []func(string) bool{
+		func(candidate string) bool { return strings.EqualFold(candidate, name) },
+		func(candidate string) bool {
+			return strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(name))
+		},
+	} {
+		var matches []string
+		for _, candidate := range candidates {
+			if match(candidate) {
+				matches = append(matches, candidate)
+			}
+		}
+		if len(matches) == 1 {
+			return matches[0], true
+		}
+		if len(matches) > 1 {
+			return "", false
+		}
+	}
+	return "", false
+}
+
+// resolveName returns name if it is one of candidates. Otherwise, if fuzzy matching is enabled,
+// it returns the unique fuzzy match. If neither applies, it returns an error suggesting close matches.
+// kind describes what is being named (e.g. "scene") for use in the error.
+func resolveName(ctx *context, kind string, name string, candidates []string) (string, error) {
+	if slices.Contains(candidates, name) {
+		return name, nil
+	}
+
+	if ctx.Fuzzy {
+		if match, ok := fuzzyMatch(name, candidates); ok {
+			return match, nil
+		}
+	}
+
+	return "", notFoundError(ctx, kind, name, candidates)
+}
+
+// exactNames returns a copy of ctx which resolves names without fuzzy matching, for commands
+// which delete what they resolve.
+func exactNames(ctx *context) *context {
+	exact := *ctx
+	exact.Fuzzy = false
+	return &exact
+}
+
+// notFoundError returns an error for an unknown name, suggesting the closest candidates.
+func notFoundError(ctx *context, kind string, name string, candidates []string) error {
+	suggestions := suggestNames(name, candidates)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("%s %s not found", kind, ctx.Style.Error(name))
+	}
+	for i, s := range suggestions {
+		suggestions[i] = ctx.Style.Highlight(s)
+	}
+	return fmt.Errorf(
+		"%s %s not found. did you mean %s?",
+		kind,
+		ctx.Style.Error(name),
+		strings.Join(suggestions, ", "),
+	)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"scene", "scene", 0},
+		{"scene", "scnee", 2},
+		{"kitten", "sitting", 3},
+		{"Mic/Aux", "mic/aux", 2},
+	}
+
+	for _, test := range tests {
+		result := levenshtein(test.a, test.b)
+		if result != test.expected {
+			t.Errorf("Expected distance between '%s' and '%s' to be %d but got %d",
+				test.a, test.b, test.expected, result)
+		}
+	}
+}
+
+func TestSuggestNames(t *testing.T) {
+	candidates := []string{"Starting Soon", "Live", "Be Right Back", "Ending"}
+
+	suggestions := suggestNames("Liev", candidates)
+	if len(suggestions) == 0 || suggestions[0] != "Live" {
+		t.Errorf("Expected 'Live' to be the first suggestion, got %v", suggestions)
+	}
+
+	suggestions = suggestNames("starting", candidates)
+	if !slices.Contains(suggestions, "Starting Soon") {
+		t.Errorf("Expected 'Starting Soon' to be suggested, got %v", suggestions)
+	}
+
+	suggestions = suggestNames("Intermission", candidates)
+	if len(suggestions) != 0 {
+		t.Errorf("Expected no suggestions, got %v", suggestions)
+	}
+}
+
+func TestFuzzyMatch(t *testing.T) {
+	candidates := []string{"Camera 1", "Camera 2", "Desktop Audio", "Mic/Aux"}
+
+	tests := []struct {
+		name     string
+		expected string
+		ok       bool
+	}{
+		{"mic/aux", "Mic/Aux", true},
+		{"desk", "Desktop Audio", true},
+		{"camera", "", false},
+		{"camera 2", "Camera 2", true},
+		{"Browser", "", false},
+	}
+
+	for _, test := range tests {
+		result, ok := fuzzyMatch(test.name, candidates)
+		if result != test.expected || ok != test.ok {
+			t.Errorf("Expected fuzzyMatch('%s') to be ('%s', %v) but got ('%s', %v)",
+				test.name, test.expected, test.ok, result, ok)
+		}
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	var out bytes.Buffer
+	context := newContext(nil, &out, StyleConfig{})
+	candidates := []string{"Untitled", "gobs-test-profile"}
+
+	_, err := resolveName(context, "profile", "gobs-test", candidates)
+	if err == nil {
+		t.Fatal("Expected error for unknown profile without --fuzzy, but got none")
+	}
+	if !strings.Contains(err.Error(), "did you mean gobs-test-profile?") {
+		t.Fatalf("Expected error to suggest 'gobs-test-profile', got '%s'", err.Error())
+	}
+
+	context.Fuzzy = true
+	name, err := resolveName(context, "profile", "gobs-test", candidates)
+	if err != nil {
+		t.Fatalf("Failed to resolve profile with --fuzzy: %v", err)
+	}
+	if name != "gobs-test-profile" {
+		t.Fatalf("Expected 'gobs-test-profile', got '%s'", name)
+	}
+}
+
+func TestExactNames(t *testing.T) {
+	var out bytes.Buffer
+	context := newContext(nil, &out, StyleConfig{})
+	context.Fuzzy = true
+
+	if _, err := resolveName(exactNames(context), "input", "mic", []string{"Mic/Aux"}); err == nil {
+		t.Fatal("Expected error resolving a partial name exactly, but got none")
+	}
+	if !context.Fuzzy {
+		t.Fatal("Expected the original context to keep fuzzy matching")
+	}
+}
@@ -8,6 +8,7 @@ require (
 	github.com/andreykaipov/goobs v1.9.0
 	github.com/charmbracelet/lipgloss v1.1.0
 	github.com/jotaen/kong-completion v0.0.14
+	github.com/mattn/go-isatty v0.0.24
 	github.com/titusjaka/kong-dotenv-go v0.1.0
 )
 
@@ -26,7 +27,6 @@ require (
 	github.com/hashicorp/logutils v1.0.0 // indirect
 	github.com/joho/godotenv v1.5.1 // indirect
 	github.com/lucasb-eyer/go-colorful v1.4.1 // indirect
-	github.com/mattn/go-isatty v0.0.24 // indirect
 	github.com/mattn/go-runewidth v0.0.27 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/mmcloughlin/profile v0.1.1 // indirect
@@ -2,24 +2,30 @@ package main
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/andreykaipov/goobs/api/requests/sceneitems"
+	"github.com/andreykaipov/goobs/api/typedefs"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/lipgloss/tree"
 )
 
 // GroupCmd provides commands to manage groups in OBS Studio.
 type GroupCmd struct {
-	List   GroupListCmd   `cmd:"" help:"List all groups."    aliases:"ls" completion-enabled-command-alias:"false"`
-	Show   GroupShowCmd   `cmd:"" help:"Show group details." aliases:"sh" completion-enabled-command-alias:"false"`
-	Hide   GroupHideCmd   `cmd:"" help:"Hide group."         aliases:"h"  completion-enabled-command-alias:"false"`
-	Toggle GroupToggleCmd `cmd:"" help:"Toggle group."       aliases:"tg" completion-enabled-command-alias:"false"`
-	Status GroupStatusCmd `cmd:"" help:"Get group status."   aliases:"ss" completion-enabled-command-alias:"false"`
+	List      GroupListCmd      `cmd:"" help:"List all groups."                             aliases:"ls" completion-enabled-command-alias:"false"`
+	Show      GroupShowCmd      `cmd:"" help:"Show group details."                          aliases:"sh" completion-enabled-command-alias:"false"`
+	Hide      GroupHideCmd      `cmd:"" help:"Hide group."                                  aliases:"h"  completion-enabled-command-alias:"false"`
+	Toggle    GroupToggleCmd    `cmd:"" help:"Toggle group."                                aliases:"tg" completion-enabled-command-alias:"false"`
+	Status    GroupStatusCmd    `cmd:"" help:"Get group status."                            aliases:"ss" completion-enabled-command-alias:"false"`
+	Transform GroupTransformCmd `cmd:"" help:"Transform group."                             aliases:"t"  completion-enabled-command-alias:"false"`
+	Ungroup   GroupUngroupCmd   `cmd:"" help:"Ungroup a group, keeping its items in place." aliases:"ug" completion-enabled-command-alias:"false"`
 }
 
 // GroupListCmd provides a command to list all groups in a scene.
 type GroupListCmd struct {
-	SceneName string `arg:"" help:"Name of the scene to list groups from." default:""`
+	Tree      bool   `flag:"" help:"Display group members as a tree."`
+	SceneName string `        help:"Name of the scene to list groups from." arg:"" default:""`
 }
 
 // Run executes the command to list all groups in a scene.
@@ -39,6 +45,10 @@ func (cmd *GroupListCmd) Run(ctx *context) error {
 		return fmt.Errorf("failed to get scene item list: %w", err)
 	}
 
+	if cmd.Tree {
+		return cmd.renderTree(ctx, resp.SceneItems)
+	}
+
 	t := table.New().Border(lipgloss.RoundedBorder()).
 		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border)).
 		Headers("ID", "Group Name", "Enabled").
@@ -84,6 +94,67 @@ func (cmd *GroupListCmd) Run(ctx *context) error {
 	return nil
 }
 
+// renderTree prints the groups in a scene with their members, showing the effective visibility of each item.
+func (cmd *GroupListCmd) renderTree(ctx *context, items []*typedefs.SceneItem) error {
+	t := tree.Root(cmd.SceneName).
+		EnumeratorStyle(lipgloss.NewStyle().Foreground(ctx.Style.border)).
+		RootStyle(lipgloss.NewStyle().Bold(true))
+
+	var found bool
+	for _, item := range items {
+		if item.IsGroup {
+			node, err := groupTree(ctx, item, item.SceneItemEnabled)
+			if err != nil {
+				return err
+			}
+			t.Child(node)
+			found = true
+		}
+	}
+
+	if !found {
+		fmt.Fprintf(ctx.Out, "No groups found in scene %s.\n", ctx.Style.Highlight(cmd.SceneName))
+		return nil
+	}
+
+	fmt.Fprintln(ctx.Out, t.String())
+	return nil
+}
+
+// groupTree returns a tree of the members of a group, recursing into nested groups.
+// An item is only effectively visible if it and every group containing it are enabled.
+func groupTree(ctx *context, group *typedefs.SceneItem, visible bool) (*tree.Tree, error) {
+	resp, err := ctx.Client.SceneItems.GetGroupSceneItemList(
+		sceneitems.NewGetGroupSceneItemListParams().WithSceneName(group.SourceName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get group scene item list for group %s: %w",
+			ctx.Style.Error(group.SourceName),
+			err,
+		)
+	}
+
+	sort.Slice(resp.SceneItems, func(i, j int) bool {
+		return resp.SceneItems[i].SceneItemID < resp.SceneItems[j].SceneItemID
+	})
+
+	node := tree.Root(fmt.Sprintf("%s %s", getEnabledMark(visible), group.SourceName))
+	for _, item := range resp.SceneItems {
+		itemVisible := visible && item.SceneItemEnabled
+		if item.IsGroup {
+			child, err := groupTree(ctx, item, itemVisible)
+			if err != nil {
+				return nil, err
+			}
+			node.Child(child)
+			continue
+		}
+		node.Child(fmt.Sprintf("%s %s", getEnabledMark(itemVisible), item.SourceName))
+	}
+	return node, nil
+}
+
 // GroupShowCmd provides a command to show a group in a scene.
 type GroupShowCmd struct {
 	SceneName string `arg:"" help:"Name of the scene to show group from."`
@@ -246,3 +317,145 @@ func (cmd *GroupStatusCmd) Run(ctx *context) error {
 		ctx.Style.Error(cmd.SceneName),
 	)
 }
+
+// getGroup returns the group scene item named groupName in a scene.
+func getGroup(ctx *context, sceneName, groupName string) (*typedefs.SceneItem, error) {
+	resp, err := ctx.Client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().
+		WithSceneName(sceneName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scene item list: %w", err)
+	}
+	for _, item := range resp.SceneItems {
+		if item.IsGroup && item.SourceName == groupName {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"group %s not found in scene %s",
+		ctx.Style.Error(groupName),
+		ctx.Style.Error(sceneName),
+	)
+}
+
+// GroupTransformCmd provides a command to transform a group in a scene.
+type GroupTransformCmd struct {
+	SceneName string `arg:"" help:"Name of the scene containing the group."`
+	GroupName string `arg:"" help:"Name of the group to transform."`
+
+	TransformFlags `embed:""`
+}
+
+// Run executes the command to transform a group in a scene.
+func (cmd *GroupTransformCmd) Run(ctx *context) error {
+	group, err := getGroup(ctx, cmd.SceneName, cmd.GroupName)
+	if err != nil {
+		return err
+	}
+
+	transform := group.SceneItemTransform
+	cmd.apply(&transform)
+
+	err = setItemTransform(ctx.Client, cmd.SceneName, group.SceneItemID, &transform)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Group %s in scene %s transformed.\n",
+		ctx.Style.Highlight(cmd.GroupName),
+		ctx.Style.Highlight(cmd.SceneName),
+	)
+	return nil
+}
+
+// GroupUngroupCmd provides a command to ungroup a group in a scene.
+//
+// OBS WebSocket cannot create or remove items inside a group, so the group's items
+// are recreated in the scene with their transforms converted to scene coordinates,
+// then the group is removed. The same technique cannot provide group create, add or
+// remove-item: no request creates a group and CreateSceneItem, DuplicateSceneItem
+// and RemoveSceneItem reject a group as the target.
+type GroupUngroupCmd struct {
+	SceneName string `arg:"" help:"Name of the scene containing the group."`
+	GroupName string `arg:"" help:"Name of the group to ungroup."`
+}
+
+// Run executes the command to ungroup a group in a scene.
+func (cmd *GroupUngroupCmd) Run(ctx *context) error {
+	group, err := getGroup(ctx, cmd.SceneName, cmd.GroupName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ctx.Client.SceneItems.GetGroupSceneItemList(
+		sceneitems.NewGetGroupSceneItemListParams().WithSceneName(cmd.GroupName),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get group scene item list: %w", err)
+	}
+	items := resp.SceneItems
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].SceneItemIndex < items[j].SceneItemIndex
+	})
+
+	for i, item := range items {
+		created, err := ctx.Client.SceneItems.CreateSceneItem(
+			sceneitems.NewCreateSceneItemParams().
+				WithSceneName(cmd.SceneName).
+				WithSourceName(item.SourceName).
+				WithSceneItemEnabled(group.SceneItemEnabled && item.SceneItemEnabled),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create scene item %s: %w", item.SourceName, err)
+		}
+
+		transform := composeGroupTransform(group.SceneItemTransform, item.SceneItemTransform)
+		if err := setItemTransform(ctx.Client, cmd.SceneName, created.SceneItemId, &transform); err != nil {
+			return fmt.Errorf("failed to set scene item transform: %w", err)
+		}
+
+		// Stack the new items directly above the group so they take its place once it is removed
+		_, err = ctx.Client.SceneItems.SetSceneItemIndex(sceneitems.NewSetSceneItemIndexParams().
+			WithSceneName(cmd.SceneName).
+			WithSceneItemId(created.SceneItemId).
+			WithSceneItemIndex(group.SceneItemIndex + 1 + i))
+		if err != nil {
+			return fmt.Errorf("failed to set scene item index: %w", err)
+		}
+
+		_, err = ctx.Client.SceneItems.SetSceneItemLocked(sceneitems.NewSetSceneItemLockedParams().
+			WithSceneName(cmd.SceneName).
+			WithSceneItemId(created.SceneItemId).
+			WithSceneItemLocked(item.SceneItemLocked))
+		if err != nil {
+			return fmt.Errorf("failed to set scene item locked: %w", err)
+		}
+
+		_, err = ctx.Client.SceneItems.SetSceneItemBlendMode(
+			sceneitems.NewSetSceneItemBlendModeParams().
+				WithSceneName(cmd.SceneName).
+				WithSceneItemId(created.SceneItemId).
+				WithSceneItemBlendMode(item.SceneItemBlendMode),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set scene item blend mode: %w", err)
+		}
+	}
+
+	_, err = ctx.Client.SceneItems.RemoveSceneItem(sceneitems.NewRemoveSceneItemParams().
+		WithSceneName(cmd.SceneName).
+		WithSceneItemId(group.SceneItemID))
+	if err != nil {
+		return fmt.Errorf("failed to remove group: %w", err)
+	}
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Ungrouped %d items from group %s into scene %s.\n",
+		len(items),
+		ctx.Style.Highlight(cmd.GroupName),
+		ctx.Style.Highlight(cmd.SceneName),
+	)
+	return nil
+}
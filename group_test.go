@@ -131,3 +131,56 @@ func TestGroupStatus(t *testing.T) {
 		t.Fatalf("Expected output to be 'Group test_group is shown.', got '%s'", out.String())
 	}
 }
+
+func TestGroupListTree(t *testing.T) {
+	skipIfSkipGroupTests(t)
+
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &GroupListCmd{
+		SceneName: "Scene",
+		Tree:      true,
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to list groups as a tree: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "Scene\n") {
+		t.Fatalf("Expected tree to be rooted at 'Scene', got '%s'", out.String())
+	}
+	if !strings.Contains(out.String(), "test_group") {
+		t.Fatalf("Expected output to contain 'test_group', got '%s'", out.String())
+	}
+}
+
+func TestGroupTransform(t *testing.T) {
+	skipIfSkipGroupTests(t)
+
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &GroupTransformCmd{
+		SceneName: "Scene",
+		GroupName: "test_group",
+	}
+	if err := cmd.Rotation.parse("+=0"); err != nil {
+		t.Fatalf("Failed to parse rotation: %v", err)
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to transform group: %v", err)
+	}
+	if out.String() != "Group test_group in scene Scene transformed.\n" {
+		t.Fatalf(
+			"Expected output to be 'Group test_group in scene Scene transformed.', got '%s'",
+			out.String(),
+		)
+	}
+}
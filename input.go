@@ -2,50 +2,98 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/andreykaipov/goobs/api/requests/inputs"
+	"github.com/andreykaipov/goobs/api/typedefs"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 )
 
 // InputCmd provides commands to manage inputs in OBS Studio.
 type InputCmd struct {
-	Create       InputCreateCmd       `cmd:"" help:"Create input."                           aliases:"c"  completion-enabled-command-alias:"false"`
-	Remove       InputRemoveCmd       `cmd:"" help:"Remove input."                           aliases:"d"  completion-enabled-command-alias:"false"`
-	List         InputListCmd         `cmd:"" help:"List all inputs."                        aliases:"ls" completion-enabled-command-alias:"false"`
-	ListKinds    InputListKindsCmd    `cmd:"" help:"List input kinds."                       aliases:"k"  completion-enabled-command-alias:"false"`
-	Mute         InputMuteCmd         `cmd:"" help:"Mute input."                             aliases:"m"  completion-enabled-command-alias:"false"`
-	Unmute       InputUnmuteCmd       `cmd:"" help:"Unmute input."                           aliases:"um" completion-enabled-command-alias:"false"`
-	Toggle       InputToggleCmd       `cmd:"" help:"Toggle input."                           aliases:"tg" completion-enabled-command-alias:"false"`
-	Volume       InputVolumeCmd       `cmd:"" help:"Set input volume."                       aliases:"v"  completion-enabled-command-alias:"false"`
-	Show         InputShowCmd         `cmd:"" help:"Show input details."                     aliases:"s"  completion-enabled-command-alias:"false"`
-	Update       InputUpdateCmd       `cmd:"" help:"Update input settings."                  aliases:"up" completion-enabled-command-alias:"false"`
-	KindDefaults InputKindDefaultsCmd `cmd:"" help:"Get default settings for an input kind." aliases:"df" completion-enabled-command-alias:"false"`
+	Create       InputCreateCmd       `cmd:"" help:"Create input."                                     aliases:"c"  completion-enabled-command-alias:"false"`
+	Remove       InputRemoveCmd       `cmd:"" help:"Remove input."                                     aliases:"d"  completion-enabled-command-alias:"false"`
+	List         InputListCmd         `cmd:"" help:"List all inputs."                                  aliases:"ls" completion-enabled-command-alias:"false"`
+	ListKinds    InputListKindsCmd    `cmd:"" help:"List input kinds."                                 aliases:"k"  completion-enabled-command-alias:"false"`
+	Mute         InputMuteCmd         `cmd:"" help:"Mute input."                                       aliases:"m"  completion-enabled-command-alias:"false"`
+	Unmute       InputUnmuteCmd       `cmd:"" help:"Unmute input."                                     aliases:"um" completion-enabled-command-alias:"false"`
+	Toggle       InputToggleCmd       `cmd:"" help:"Toggle input."                                     aliases:"tg" completion-enabled-command-alias:"false"`
+	Volume       InputVolumeCmd       `cmd:"" help:"Set input volume."                                 aliases:"v"  completion-enabled-command-alias:"false"`
+	Show         InputShowCmd         `cmd:"" help:"Show input details."                               aliases:"s"  completion-enabled-command-alias:"false"`
+	Update       InputUpdateCmd       `cmd:"" help:"Update input settings."                            aliases:"up" completion-enabled-command-alias:"false"`
+	KindDefaults InputKindDefaultsCmd `cmd:"" help:"Get default settings for an input kind."           aliases:"df" completion-enabled-command-alias:"false"`
+	Rename       InputRenameCmd       `cmd:"" help:"Rename input."                                     aliases:"rn" completion-enabled-command-alias:"false"`
+	Settings     InputSettingsCmd     `cmd:"" help:"Get/Set input settings."                           aliases:"st" completion-enabled-command-alias:"false"`
+	Properties   InputPropertiesCmd   `cmd:"" help:"List input properties and their options."          aliases:"pr" completion-enabled-command-alias:"false"`
+	Press        InputPressCmd        `cmd:"" help:"Press a button in the input properties."           aliases:"p"  completion-enabled-command-alias:"false"`
+	Audio        InputAudioCmd        `cmd:"" help:"Get/Set input audio properties."                   aliases:"a"  completion-enabled-command-alias:"false"`
+	Fade         InputFadeCmd         `cmd:"" help:"Fade input volume."                                aliases:"f"  completion-enabled-command-alias:"false"`
+	Meter        InputMeterCmd        `cmd:"" help:"Show live audio levels of inputs."                 aliases:"mt" completion-enabled-command-alias:"false"`
+	Duck         InputDuckCmd         `cmd:"" help:"Lower input volume while another input is active." aliases:"dk" completion-enabled-command-alias:"false"`
 }
 
 // InputCreateCmd provides a command to create an input.
 type InputCreateCmd struct {
 	Name string `arg:"" help:"Name for the input."                                          required:""`
 	Kind string `arg:"" help:"Input kind (e.g., coreaudio_input_capture, macos-avcapture)." required:""`
+
+	Scene        string `flag:"" help:"Scene name or UUID to add the input to, defaults to the current scene."`
+	SettingsJSON string `flag:"" help:"Settings for the input as a JSON object."                               name:"settings-json" placeholder:"JSON"`
+	Disabled     bool   `flag:"" help:"Add the input to the scene hidden."`
 }
 
 // Run executes the command to create an input.
 func (cmd *InputCreateCmd) Run(ctx *context) error {
-	currentScene, err := ctx.Client.Scenes.GetCurrentProgramScene()
+	var settings map[string]any
+	if cmd.SettingsJSON != "" {
+		if err := decodeJSON([]byte(cmd.SettingsJSON), &settings); err != nil {
+			return fmt.Errorf("failed to parse settings JSON: %w", err)
+		}
+	}
+
+	resp, err := ctx.Client.Inputs.GetInputKindList(inputs.NewGetInputKindListParams())
+	if err != nil {
+		return fmt.Errorf("failed to get input kinds: %w", err)
+	}
+
+	// CreateInput only accepts versioned kinds, so map color_source to color_source_v3
+	if kind, ok := latestKindVersion(cmd.Kind, resp.InputKinds); ok {
+		cmd.Kind = kind
+	}
+	cmd.Kind, err = resolveName(ctx, "input kind", cmd.Kind, resp.InputKinds)
 	if err != nil {
 		return err
 	}
 
-	_, err = ctx.Client.Inputs.CreateInput(
-		inputs.NewCreateInputParams().
-			WithInputKind(cmd.Kind).
-			WithInputName(cmd.Name).
-			WithSceneName(currentScene.CurrentProgramSceneName),
-	)
+	if cmd.Scene == "" {
+		currentScene, err := ctx.Client.Scenes.GetCurrentProgramScene()
+		if err != nil {
+			return err
+		}
+		cmd.Scene = currentScene.CurrentProgramSceneName
+	} else {
+		cmd.Scene, err = resolveSceneName(ctx, cmd.Scene)
+		if err != nil {
+			return err
+		}
+	}
+
+	params := inputs.NewCreateInputParams().
+		WithInputKind(cmd.Kind).
+		WithInputName(cmd.Name).
+		WithSceneName(cmd.Scene).
+		WithSceneItemEnabled(!cmd.Disabled)
+	if settings != nil {
+		params = params.WithInputSettings(settings)
+	}
+	_, err = ctx.Client.Inputs.CreateInput(params)
 	if err != nil {
 		return err
 	}
@@ -57,21 +105,53 @@ func (cmd *InputCreateCmd) Run(ctx *context) error {
 			cmd.Name,
 		),
 		cmd.Kind,
-		ctx.Style.Highlight(currentScene.CurrentProgramSceneName),
+		ctx.Style.Highlight(cmd.Scene),
 	)
 	return nil
 }
 
+// latestKindVersion returns the latest versioned kind (e.g. color_source_v3) for an unversioned
+// kind name. Kinds which are already in the list are returned unchanged.
+func latestKindVersion(kind string, kinds []string) (string, bool) {
+	if slices.Contains(kinds, kind) {
+		return kind, true
+	}
+
+	var latest string
+	latestVersion := 0
+	for _, k := range kinds {
+		suffix, ok := strings.CutPrefix(k, kind+"_v")
+		if !ok {
+			continue
+		}
+		version, err := strconv.Atoi(suffix)
+		if err != nil {
+			continue
+		}
+		if version > latestVersion {
+			latest, latestVersion = k, version
+		}
+	}
+	return latest, latest != ""
+}
+
 // InputRemoveCmd provides a command to remove an input.
 type InputRemoveCmd struct {
-	Name string `arg:"" help:"Name of the input to remove." required:""`
+	Name string `arg:"" help:"Name or UUID of the input to remove." required:""`
 }
 
 // Run executes the command to remove an input.
 func (cmd *InputRemoveCmd) Run(ctx *context) error {
-	_, err := ctx.Client.Inputs.RemoveInput(
-		inputs.NewRemoveInputParams().WithInputName(cmd.Name),
-	)
+	// Fuzzy matching is not applied here, an input is only deleted by its exact name
+	name, err := resolveInputName(exactNames(ctx), cmd.Name)
+	if err != nil {
+		return err
+	}
+	cmd.Name = name
+
+	params := inputs.NewRemoveInputParams()
+	params = withInput(params, cmd.Name)
+	_, err = ctx.Client.Inputs.RemoveInput(params)
 	if err != nil {
 		return fmt.Errorf("failed to delete input: %w", err)
 	}
@@ -86,7 +166,7 @@ type InputListCmd struct {
 	Output bool `flag:"" help:"List all outputs."        aliases:"o"`
 	Colour bool `flag:"" help:"List all colour sources." aliases:"c"`
 	Ffmpeg bool `flag:"" help:"List all ffmpeg sources." aliases:"f"`
-	Vlc    bool `flag:"" help:"List all VLC sources."    aliases:"v"`
+	Vlc    bool `flag:"" help:"List all VLC sources."`
 	UUID   bool `flag:"" help:"Display UUIDs of inputs." aliases:"u"`
 }
 
@@ -230,14 +310,20 @@ func (cmd *InputListKindsCmd) Run(ctx *context) error {
 
 // InputMuteCmd provides a command to mute an input.
 type InputMuteCmd struct {
-	InputName string `arg:"" help:"Name of the input to mute."`
+	InputName string `arg:"" help:"Name or UUID of the input to mute."`
 }
 
 // Run executes the command to mute an input.
 func (cmd *InputMuteCmd) Run(ctx *context) error {
-	_, err := ctx.Client.Inputs.SetInputMute(
-		inputs.NewSetInputMuteParams().WithInputName(cmd.InputName).WithInputMuted(true),
-	)
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewSetInputMuteParams().WithInputMuted(true)
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputMute(params)
 	if err != nil {
 		return fmt.Errorf("failed to mute input: %w", err)
 	}
@@ -248,14 +334,20 @@ func (cmd *InputMuteCmd) Run(ctx *context) error {
 
 // InputUnmuteCmd provides a command to unmute an input.
 type InputUnmuteCmd struct {
-	InputName string `arg:"" help:"Name of the input to unmute."`
+	InputName string `arg:"" help:"Name or UUID of the input to unmute."`
 }
 
 // Run executes the command to unmute an input.
 func (cmd *InputUnmuteCmd) Run(ctx *context) error {
-	_, err := ctx.Client.Inputs.SetInputMute(
-		inputs.NewSetInputMuteParams().WithInputName(cmd.InputName).WithInputMuted(false),
-	)
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewSetInputMuteParams().WithInputMuted(false)
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputMute(params)
 	if err != nil {
 		return fmt.Errorf("failed to unmute input: %w", err)
 	}
@@ -266,23 +358,29 @@ func (cmd *InputUnmuteCmd) Run(ctx *context) error {
 
 // InputToggleCmd provides a command to toggle the mute state of an input.
 type InputToggleCmd struct {
-	InputName string `arg:"" help:"Name of the input to toggle."`
+	InputName string `arg:"" help:"Name or UUID of the input to toggle."`
 }
 
 // Run executes the command to toggle the mute state of an input.
 func (cmd *InputToggleCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
 	// Get the current mute state of the input
-	resp, err := ctx.Client.Inputs.GetInputMute(
-		inputs.NewGetInputMuteParams().WithInputName(cmd.InputName),
-	)
+	getParams := inputs.NewGetInputMuteParams()
+	setParams := inputs.NewSetInputMuteParams()
+	getParams = withInput(getParams, cmd.InputName)
+	setParams = withInput(setParams, cmd.InputName)
+	resp, err := ctx.Client.Inputs.GetInputMute(getParams)
 	if err != nil {
 		return fmt.Errorf("failed to get input mute state: %w", err)
 	}
 	// Toggle the mute state
 	newMuteState := !resp.InputMuted
-	_, err = ctx.Client.Inputs.SetInputMute(
-		inputs.NewSetInputMuteParams().WithInputName(cmd.InputName).WithInputMuted(newMuteState),
-	)
+	_, err = ctx.Client.Inputs.SetInputMute(setParams.WithInputMuted(newMuteState))
 	if err != nil {
 		return fmt.Errorf("failed to toggle input mute state: %w", err)
 	}
@@ -297,8 +395,8 @@ func (cmd *InputToggleCmd) Run(ctx *context) error {
 
 // InputVolumeCmd provides a command to set the volume of an input.
 type InputVolumeCmd struct {
-	InputName string  `arg:"" help:"Name of the input to set volume for." required:""`
-	Volume    float64 `arg:"" help:"Volume level (-90.0 to 0.0)."         required:""`
+	InputName string  `arg:"" help:"Name or UUID of the input to set volume for." required:""`
+	Volume    float64 `arg:"" help:"Volume level (-90.0 to 0.0)."                 required:""`
 }
 
 // Run executes the command to set the volume of an input.
@@ -308,11 +406,15 @@ func (cmd *InputVolumeCmd) Run(ctx *context) error {
 		return fmt.Errorf("volume must be between -90.0 and 0.0 dB")
 	}
 
-	_, err := ctx.Client.Inputs.SetInputVolume(
-		inputs.NewSetInputVolumeParams().
-			WithInputName(cmd.InputName).
-			WithInputVolumeDb(cmd.Volume),
-	)
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewSetInputVolumeParams().WithInputVolumeDb(cmd.Volume)
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputVolume(params)
 	if err != nil {
 		return fmt.Errorf("failed to set input volume: %w", err)
 	}
@@ -335,20 +437,23 @@ func (cmd *InputShowCmd) Run(ctx *context) error {
 		return fmt.Errorf("failed to get input list: %w", err)
 	}
 
+	names := make([]string, 0, len(lresp.Inputs))
+	for _, input := range lresp.Inputs {
+		names = append(names, input.InputName)
+	}
+	cmd.Name, err = resolveName(ctx, "input", cmd.Name, names)
+	if err != nil {
+		return err
+	}
+
 	var inputKind string
-	var found bool
 	for _, input := range lresp.Inputs {
 		if input.InputName == cmd.Name {
 			inputKind = input.InputKind
-			found = true
 			break
 		}
 	}
 
-	if !found {
-		return fmt.Errorf("input '%s' not found", cmd.Name)
-	}
-
 	prop, name := device(ctx, cmd.Name)
 	if prop == "" {
 		return fmt.Errorf("no device property found for input '%s'", cmd.Name)
@@ -382,11 +487,7 @@ func (cmd *InputShowCmd) Run(ctx *context) error {
 	fmt.Fprintln(ctx.Out, t.Render())
 
 	if cmd.Verbose {
-		deviceListResp, err := ctx.Client.Inputs.GetInputPropertiesListPropertyItems(
-			inputs.NewGetInputPropertiesListPropertyItemsParams().
-				WithInputName(cmd.Name).
-				WithPropertyName(prop),
-		)
+		devices, err := listPropertyItems(ctx, cmd.Name, prop)
 		if err != nil {
 			return fmt.Errorf("failed to get device list: %w", err)
 		}
@@ -411,7 +512,7 @@ func (cmd *InputShowCmd) Run(ctx *context) error {
 
 		t.Headers("Devices")
 
-		for _, item := range deviceListResp.PropertyItems {
+		for _, item := range devices {
 			if item.ItemName != "" {
 				t.Row(item.ItemName)
 			}
@@ -424,21 +525,17 @@ func (cmd *InputShowCmd) Run(ctx *context) error {
 }
 
 func device(ctx *context, inputName string) (string, string) {
-	settings, err := ctx.Client.Inputs.GetInputSettings(
-		inputs.NewGetInputSettingsParams().WithInputName(inputName),
-	)
+	params := inputs.NewGetInputSettingsParams()
+	params = withInput(params, inputName)
+	settings, err := ctx.Client.Inputs.GetInputSettings(params)
 	if err != nil {
 		return "", ""
 	}
 
 	for _, propName := range []string{"device", "device_id"} {
-		deviceListResp, err := ctx.Client.Inputs.GetInputPropertiesListPropertyItems(
-			inputs.NewGetInputPropertiesListPropertyItemsParams().
-				WithInputName(inputName).
-				WithPropertyName(propName),
-		)
-		if err == nil && len(deviceListResp.PropertyItems) > 0 {
-			for _, item := range deviceListResp.PropertyItems {
+		items, err := listPropertyItems(ctx, inputName, propName)
+		if err == nil && len(items) > 0 {
+			for _, item := range items {
 				if item.ItemValue == settings.InputSettings[propName] {
 					return propName, item.ItemName
 				}
@@ -449,32 +546,49 @@ func device(ctx *context, inputName string) (string, string) {
 	return "", ""
 }
 
+// listPropertyItems returns the items of a list property of an input.
+// OBS returns an error if the property is not a list property.
+func listPropertyItems(
+	ctx *context,
+	inputName, propName string,
+) ([]*typedefs.PropertyItem, error) {
+	params := inputs.NewGetInputPropertiesListPropertyItemsParams().WithPropertyName(propName)
+	params = withInput(params, inputName)
+	resp, err := ctx.Client.Inputs.GetInputPropertiesListPropertyItems(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.PropertyItems, nil
+}
+
 // InputUpdateCmd provides a command to update input settings.
 type InputUpdateCmd struct {
-	InputName  string `arg:"" help:"Name of the input to update." required:""`
-	DeviceName string `arg:"" help:"Name of the device to set."   required:""`
+	InputName  string `arg:"" help:"Name or UUID of the input to update." required:""`
+	DeviceName string `arg:"" help:"Name of the device to set."           required:""`
 }
 
 // Run executes the command to update input settings.
 func (cmd *InputUpdateCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
 	// Use the device helper to find the correct device property name
 	prop, _ := device(ctx, cmd.InputName)
 	if prop == "" {
 		return fmt.Errorf("no device property found for input '%s'", cmd.InputName)
 	}
 
-	resp, err := ctx.Client.Inputs.GetInputPropertiesListPropertyItems(
-		inputs.NewGetInputPropertiesListPropertyItemsParams().
-			WithInputName(cmd.InputName).
-			WithPropertyName(prop),
-	)
+	items, err := listPropertyItems(ctx, cmd.InputName, prop)
 	if err != nil {
 		return err
 	}
 
 	var deviceValue any
 	var found bool
-	for _, item := range resp.PropertyItems {
+	for _, item := range items {
 		if item.ItemName == cmd.DeviceName {
 			deviceValue = item.ItemValue
 			found = true
@@ -486,9 +600,9 @@ func (cmd *InputUpdateCmd) Run(ctx *context) error {
 		return fmt.Errorf("device '%s' not found for input '%s'", cmd.DeviceName, cmd.InputName)
 	}
 
-	sresp, err := ctx.Client.Inputs.GetInputSettings(
-		inputs.NewGetInputSettingsParams().WithInputName(cmd.InputName),
-	)
+	getParams := inputs.NewGetInputSettingsParams()
+	getParams = withInput(getParams, cmd.InputName)
+	sresp, err := ctx.Client.Inputs.GetInputSettings(getParams)
 	if err != nil {
 		return err
 	}
@@ -497,11 +611,9 @@ func (cmd *InputUpdateCmd) Run(ctx *context) error {
 	maps.Copy(settings, sresp.InputSettings)
 	settings[prop] = deviceValue
 
-	_, err = ctx.Client.Inputs.SetInputSettings(
-		inputs.NewSetInputSettingsParams().
-			WithInputName(cmd.InputName).
-			WithInputSettings(settings),
-	)
+	setParams := inputs.NewSetInputSettingsParams().WithInputSettings(settings)
+	setParams = withInput(setParams, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputSettings(setParams)
 	if err != nil {
 		return fmt.Errorf("failed to update input settings: %w", err)
 	}
@@ -563,3 +675,298 @@ func (cmd *InputKindDefaultsCmd) Run(ctx *context) error {
 	fmt.Fprintln(ctx.Out, t.Render())
 	return nil
 }
+
+// InputRenameCmd provides a command to rename an input.
+type InputRenameCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input to rename."`
+	NewName   string `arg:"" help:"New name for the input."`
+}
+
+// Run executes the command to rename an input.
+func (cmd *InputRenameCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewSetInputNameParams().WithNewInputName(cmd.NewName)
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputName(params)
+	if err != nil {
+		return fmt.Errorf("failed to rename input: %w", err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Renamed input %s to %s\n",
+		ctx.Style.Highlight(cmd.InputName), ctx.Style.Highlight(cmd.NewName))
+	return nil
+}
+
+// InputSettingsCmd provides commands to get and set input settings.
+type InputSettingsCmd struct {
+	Get InputSettingsGetCmd `cmd:"" help:"Get input settings as JSON." aliases:"g" completion-enabled-command-alias:"false"`
+	Set InputSettingsSetCmd `cmd:"" help:"Set input settings."         aliases:"s" completion-enabled-command-alias:"false"`
+}
+
+// InputSettingsGetCmd provides a command to get the settings of an input.
+type InputSettingsGetCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input."`
+}
+
+// Run executes the command to get the settings of an input.
+func (cmd *InputSettingsGetCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewGetInputSettingsParams()
+	params = withInput(params, cmd.InputName)
+	resp, err := ctx.Client.Inputs.GetInputSettings(params)
+	if err != nil {
+		return fmt.Errorf("failed to get input settings: %w", err)
+	}
+
+	data, err := json.MarshalIndent(resp.InputSettings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal input settings: %w", err)
+	}
+	fmt.Fprintln(ctx.Out, string(data))
+	return nil
+}
+
+// InputSettingsSetCmd provides a command to set the settings of an input.
+type InputSettingsSetCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input."`
+
+	SettingsFlags `embed:""`
+}
+
+// Run executes the command to set the settings of an input.
+func (cmd *InputSettingsSetCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	n, err := cmd.apply(func(settings map[string]any, overlay bool) error {
+		params := inputs.NewSetInputSettingsParams().
+			WithInputSettings(settings).
+			WithOverlay(overlay)
+		params = withInput(params, cmd.InputName)
+		_, err := ctx.Client.Inputs.SetInputSettings(params)
+		if err != nil {
+			return fmt.Errorf("failed to set input settings: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Out, "Updated %d settings of input %s\n",
+		n, ctx.Style.Highlight(cmd.InputName))
+	return nil
+}
+
+// InputPropertiesCmd provides a command to list the properties of an input.
+//
+// OBS WebSocket has no request to list the properties of an input, so the setting keys of the
+// input and its kind are queried instead. Those with list items are list properties. Buttons
+// have no setting, the known buttons of an input kind are listed from inputButtons.
+type InputPropertiesCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input."`
+	Property  string `arg:"" help:"List the options of a single property." optional:""`
+}
+
+// Run executes the command to list the properties of an input.
+// nolint: misspell
+func (cmd *InputPropertiesCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewGetInputSettingsParams()
+	params = withInput(params, cmd.InputName)
+	resp, err := ctx.Client.Inputs.GetInputSettings(params)
+	if err != nil {
+		return fmt.Errorf("failed to get input settings: %w", err)
+	}
+
+	if cmd.Property != "" {
+		return cmd.listItems(ctx, resp.InputSettings[cmd.Property])
+	}
+
+	dresp, err := ctx.Client.Inputs.GetInputDefaultSettings(
+		inputs.NewGetInputDefaultSettingsParams().WithInputKind(resp.InputKind),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get default settings for input kind '%s': %w", resp.InputKind, err)
+	}
+
+	settings := make(map[string]any)
+	maps.Copy(settings, dresp.DefaultInputSettings)
+	maps.Copy(settings, resp.InputSettings)
+
+	keys := slices.Sorted(maps.Keys(settings))
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border))
+	t.Headers("Property", "Value", "Options")
+	t.StyleFunc(func(row, col int) lipgloss.Style {
+		style := lipgloss.NewStyle().Padding(0, 3)
+		switch col {
+		case 0:
+			style = style.Align(lipgloss.Left)
+		case 1:
+			style = style.Align(lipgloss.Left)
+		case 2:
+			style = style.Align(lipgloss.Center)
+		}
+		switch {
+		case row == table.HeaderRow:
+			style = style.Bold(true).Align(lipgloss.Center)
+		case row%2 == 0:
+			style = style.Foreground(ctx.Style.evenRows)
+		default:
+			style = style.Foreground(ctx.Style.oddRows)
+		}
+		return style
+	})
+
+	for _, key := range keys {
+		options := ""
+		// List properties hold the string or numeric value of the selected item,
+		// skip the request for settings which cannot be lists
+		if isListValue(settings[key]) {
+			if items, err := listPropertyItems(ctx, cmd.InputName, key); err == nil && len(items) > 0 {
+				options = fmt.Sprintf("%d", len(items))
+			}
+		}
+		t.Row(key, formatSettingValue(settings[key]), options)
+	}
+	for _, button := range inputButtons[resp.InputKind] {
+		t.Row(button, "", "button")
+	}
+
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// isListValue reports whether value may be the selected item of a list property.
+// Booleans, objects and arrays are never list values.
+func isListValue(value any) bool {
+	switch value.(type) {
+	case string, float64, int, int64:
+		return true
+	default:
+		return false
+	}
+}
+
+// listItems prints the items of a list property, marking the currently selected item.
+// nolint: misspell
+func (cmd *InputPropertiesCmd) listItems(ctx *context, current any) error {
+	items, err := listPropertyItems(ctx, cmd.InputName, cmd.Property)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get items for property %s: %w",
+			ctx.Style.Error(cmd.Property),
+			err,
+		)
+	}
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border))
+	t.Headers("Item Name", "Item Value", "Enabled", "Selected")
+	t.StyleFunc(func(row, col int) lipgloss.Style {
+		style := lipgloss.NewStyle().Padding(0, 3)
+		switch col {
+		case 0:
+			style = style.Align(lipgloss.Left)
+		case 1:
+			style = style.Align(lipgloss.Left)
+		case 2:
+			style = style.Align(lipgloss.Center)
+		case 3:
+			style = style.Align(lipgloss.Center)
+		}
+		switch {
+		case row == table.HeaderRow:
+			style = style.Bold(true).Align(lipgloss.Center)
+		case row%2 == 0:
+			style = style.Foreground(ctx.Style.evenRows)
+		default:
+			style = style.Foreground(ctx.Style.oddRows)
+		}
+		return style
+	})
+
+	for _, item := range items {
+		t.Row(
+			item.ItemName,
+			formatSettingValue(item.ItemValue),
+			getEnabledMark(item.ItemEnabled),
+			getEnabledMark(formatSettingValue(item.ItemValue) == formatSettingValue(current)),
+		)
+	}
+
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// inputButtons lists the button properties of input kinds, which cannot be discovered from the
+// input settings. They are pressed with the input press command.
+var inputButtons = map[string][]string{
+	"browser_source": {"refreshnocache"},
+	"dshow_input":    {"activate", "video_config", "xbar_config"},
+}
+
+// InputPressCmd provides a command to press a button in the properties of an input.
+type InputPressCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input."`
+	Property  string `arg:"" help:"Name of the button property (e.g. refreshnocache for browser sources)."`
+}
+
+// Run executes the command to press a button in the properties of an input.
+func (cmd *InputPressCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	params := inputs.NewPressInputPropertiesButtonParams().WithPropertyName(cmd.Property)
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.PressInputPropertiesButton(params)
+	if err != nil {
+		return fmt.Errorf("failed to press button %s: %w", ctx.Style.Error(cmd.Property), err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Pressed %s on input %s\n",
+		ctx.Style.Highlight(cmd.Property), ctx.Style.Highlight(cmd.InputName))
+	return nil
+}
+
+// resolveInputName returns the input name unchanged if it is a UUID or the name of an input.
+// Unknown names are resolved as described in resolveName.
+func resolveInputName(ctx *context, input string) (string, error) {
+	if isUUID(input) {
+		return input, nil
+	}
+
+	resp, err := ctx.Client.Inputs.GetInputList(inputs.NewGetInputListParams())
+	if err != nil {
+		return "", fmt.Errorf("failed to get input list: %w", err)
+	}
+
+	names := make([]string, 0, len(resp.Inputs))
+	for _, input := range resp.Inputs {
+		names = append(names, input.InputName)
+	}
+	return resolveName(ctx, "input", input, names)
+}
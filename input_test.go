@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -122,3 +123,197 @@ func TestInputListFilterColour(t *testing.T) {
 		}
 	}
 }
+
+func TestInputRename(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &InputRenameCmd{
+		InputName: "gobs-test-input-2",
+		NewName:   "gobs-test-input-renamed",
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to rename input: %v", err)
+	}
+	if out.String() != "Renamed input gobs-test-input-2 to gobs-test-input-renamed\n" {
+		t.Fatalf(
+			"Expected output to be 'Renamed input gobs-test-input-2 to gobs-test-input-renamed', got '%s'",
+			out.String(),
+		)
+	}
+
+	cmd = &InputRenameCmd{
+		InputName: "gobs-test-input-renamed",
+		NewName:   "gobs-test-input-2",
+	}
+	err = cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore input name: %v", err)
+	}
+}
+
+func TestInputSettings(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdSet := &InputSettingsSetCmd{
+		InputName: "gobs-test-input",
+		SettingsFlags: SettingsFlags{
+			Settings: []string{"width=1280"},
+			Overlay:  true,
+		},
+	}
+	err := cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to set input settings: %v", err)
+	}
+	if out.String() != "Updated 1 settings of input gobs-test-input\n" {
+		t.Fatalf(
+			"Expected output to be 'Updated 1 settings of input gobs-test-input', got '%s'",
+			out.String(),
+		)
+	}
+	out.Reset()
+
+	cmdGet := &InputSettingsGetCmd{InputName: "gobs-test-input"}
+	err = cmdGet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get input settings: %v", err)
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(out.Bytes(), &settings); err != nil {
+		t.Fatalf("Expected output to be JSON, got '%s'", out.String())
+	}
+	if settings["width"] != float64(1280) {
+		t.Fatalf("Expected width to be 1280, got %v", settings["width"])
+	}
+	if settings["height"] != float64(1080) {
+		t.Fatalf("Expected height to be unchanged at 1080, got %v", settings["height"])
+	}
+
+	cmdSet.Settings = []string{"width=1920"}
+	err = cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore input settings: %v", err)
+	}
+}
+
+func TestInputProperties(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &InputPropertiesCmd{InputName: "gobs-test-input"}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to list input properties: %v", err)
+	}
+	for _, property := range []string{"color", "width", "height"} {
+		if !strings.Contains(out.String(), property) {
+			t.Fatalf("Expected output to contain '%s', got '%s'", property, out.String())
+		}
+	}
+}
+
+func TestInputCreate(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &InputCreateCmd{
+		Name:         "gobs-test-input-created",
+		Kind:         "color_source_v3",
+		Scene:        "gobs-test-scene",
+		SettingsJSON: `{"width": 640, "height": 360}`,
+		Disabled:     true,
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to create input: %v", err)
+	}
+	if out.String() != "Created input: gobs-test-input-created (color_source_v3) in scene gobs-test-scene\n" {
+		t.Fatalf(
+			"Expected output to be 'Created input: gobs-test-input-created (color_source_v3) in scene gobs-test-scene', got '%s'",
+			out.String(),
+		)
+	}
+
+	cmdRemove := &InputRemoveCmd{Name: "gobs-test-input-created"}
+	err = cmdRemove.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to remove input: %v", err)
+	}
+}
+
+func TestInputCreateUnknownKind(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &InputCreateCmd{
+		Name: "gobs-test-input-created",
+		Kind: "color_sorce_v3",
+	}
+	err := cmd.Run(context)
+	if err == nil {
+		t.Fatal("Expected an error creating an input of an unknown kind")
+	}
+	if !strings.Contains(err.Error(), "did you mean color_source_v3") {
+		t.Fatalf("Expected error to suggest 'color_source_v3', got '%s'", err.Error())
+	}
+}
+
+func TestLatestKindVersion(t *testing.T) {
+	kinds := []string{"color_source_v2", "color_source_v3", "image_source", "text_gdiplus_v10", "text_gdiplus_v9"}
+
+	tests := []struct {
+		kind string
+		want string
+		ok   bool
+	}{
+		{"color_source", "color_source_v3", true},
+		{"color_source_v2", "color_source_v2", true},
+		{"image_source", "image_source", true},
+		{"text_gdiplus", "text_gdiplus_v10", true},
+		{"color_sorce", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := latestKindVersion(tt.kind, kinds)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("latestKindVersion(%q) = %q, %v, want %q, %v", tt.kind, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestIsListValue(t *testing.T) {
+	tests := []struct {
+		value any
+		want  bool
+	}{
+		{"1920x1080", true},
+		{float64(2), true},
+		{true, false},
+		{map[string]any{"face": "Arial"}, false},
+		{[]any{"a"}, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := isListValue(tt.value); got != tt.want {
+			t.Errorf("isListValue(%v) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
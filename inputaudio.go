@@ -0,0 +1,742 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/andreykaipov/goobs/api/events"
+	"github.com/andreykaipov/goobs/api/events/subscriptions"
+	"github.com/andreykaipov/goobs/api/requests/inputs"
+	"github.com/andreykaipov/goobs/api/typedefs"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// audioTrackCount is the number of audio tracks available in OBS.
+const audioTrackCount = 6
+
+// fadeInterval is the interval between volume changes during a fade.
+const fadeInterval = 50 * time.Millisecond
+
+// minVolumeDb is the lowest volume OBS accepts in decibels, treated as silence.
+const minVolumeDb = -100.0
+
+// monitorTypes maps human readable monitor type names to OBS monitor types.
+var monitorTypes = map[string]string{
+	"none":               "OBS_MONITORING_TYPE_NONE",
+	"monitor-only":       "OBS_MONITORING_TYPE_MONITOR_ONLY",
+	"monitor-and-output": "OBS_MONITORING_TYPE_MONITOR_AND_OUTPUT",
+}
+
+// monitorTypeName returns the name of an OBS monitor type, or the raw value if it has none.
+func monitorTypeName(monitorType string) string {
+	for name, value := range monitorTypes {
+		if value == monitorType {
+			return name
+		}
+	}
+	return monitorType
+}
+
+// InputAudioCmd provides commands to manage the audio properties of inputs.
+type InputAudioCmd struct {
+	Balance InputAudioBalanceCmd `cmd:"" help:"Get/Set input audio balance."      aliases:"b" completion-enabled-command-alias:"false"`
+	Sync    InputAudioSyncCmd    `cmd:"" help:"Get/Set input audio sync offset."  aliases:"s" completion-enabled-command-alias:"false"`
+	Monitor InputAudioMonitorCmd `cmd:"" help:"Get/Set input audio monitor type." aliases:"m" completion-enabled-command-alias:"false"`
+	Tracks  InputAudioTracksCmd  `cmd:"" help:"Get/Set input audio tracks."       aliases:"t" completion-enabled-command-alias:"false"`
+	Volume  InputAudioVolumeCmd  `cmd:"" help:"Get/Set input volume."             aliases:"v" completion-enabled-command-alias:"false"`
+}
+
+// InputAudioBalanceCmd provides a command to get or set the audio balance of an input.
+type InputAudioBalanceCmd struct {
+	InputName string   `arg:"" help:"Name or UUID of the input."`
+	Balance   *float64 `arg:"" help:"Audio balance (0.0 left to 1.0 right)." optional:""`
+}
+
+// Run executes the command to get or set the audio balance of an input.
+func (cmd *InputAudioBalanceCmd) Run(ctx *context) error {
+	if cmd.Balance != nil && (*cmd.Balance < 0.0 || *cmd.Balance > 1.0) {
+		return fmt.Errorf("balance must be between 0.0 and 1.0")
+	}
+
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	if cmd.Balance == nil {
+		params := inputs.NewGetInputAudioBalanceParams()
+		params = withInput(params, cmd.InputName)
+		resp, err := ctx.Client.Inputs.GetInputAudioBalance(params)
+		if err != nil {
+			return fmt.Errorf("failed to get input audio balance: %w", err)
+		}
+
+		fmt.Fprintf(ctx.Out, "Audio balance of input %s is %.2f\n",
+			ctx.Style.Highlight(cmd.InputName), resp.InputAudioBalance)
+		return nil
+	}
+
+	params := inputs.NewSetInputAudioBalanceParams().WithInputAudioBalance(*cmd.Balance)
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputAudioBalance(params)
+	if err != nil {
+		return fmt.Errorf("failed to set input audio balance: %w", err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Set audio balance of input %s to %.2f\n",
+		ctx.Style.Highlight(cmd.InputName), *cmd.Balance)
+	return nil
+}
+
+// InputAudioSyncCmd provides a command to get or set the audio sync offset of an input.
+type InputAudioSyncCmd struct {
+	InputName numberArgName `arg:"" help:"Name or UUID of the input."`
+	Offset    *numberValue  `arg:"" help:"Audio sync offset in milliseconds (-950 to 20000)." optional:""`
+}
+
+// Run executes the command to get or set the audio sync offset of an input.
+func (cmd *InputAudioSyncCmd) Run(ctx *context) error {
+	if cmd.Offset != nil && (*cmd.Offset < -950 || *cmd.Offset > 20000) {
+		return fmt.Errorf("sync offset must be between -950 and 20000 ms")
+	}
+	if cmd.Offset != nil && math.Trunc(float64(*cmd.Offset)) != float64(*cmd.Offset) {
+		return fmt.Errorf("sync offset must be a whole number of milliseconds")
+	}
+
+	name, err := resolveInputName(ctx, string(cmd.InputName))
+	if err != nil {
+		return err
+	}
+	cmd.InputName = numberArgName(name)
+
+	if cmd.Offset == nil {
+		params := inputs.NewGetInputAudioSyncOffsetParams()
+		params = withInput(params, string(cmd.InputName))
+		resp, err := ctx.Client.Inputs.GetInputAudioSyncOffset(params)
+		if err != nil {
+			return fmt.Errorf("failed to get input audio sync offset: %w", err)
+		}
+
+		fmt.Fprintf(ctx.Out, "Audio sync offset of input %s is %.0f ms\n",
+			ctx.Style.Highlight(string(cmd.InputName)), resp.InputAudioSyncOffset)
+		return nil
+	}
+
+	params := inputs.NewSetInputAudioSyncOffsetParams().
+		WithInputAudioSyncOffset(float64(*cmd.Offset))
+	params = withInput(params, string(cmd.InputName))
+	_, err = ctx.Client.Inputs.SetInputAudioSyncOffset(params)
+	if err != nil {
+		return fmt.Errorf("failed to set input audio sync offset: %w", err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Set audio sync offset of input %s to %d ms\n",
+		ctx.Style.Highlight(string(cmd.InputName)), int(*cmd.Offset))
+	return nil
+}
+
+// InputAudioMonitorCmd provides a command to get or set the audio monitor type of an input.
+type InputAudioMonitorCmd struct {
+	InputName   string `arg:"" help:"Name or UUID of the input."`
+	MonitorType string `arg:"" help:"Audio monitor type."        optional:"" enum:",none,monitor-only,monitor-and-output" default:""`
+}
+
+// Run executes the command to get or set the audio monitor type of an input.
+func (cmd *InputAudioMonitorCmd) Run(ctx *context) error {
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	if cmd.MonitorType == "" {
+		params := inputs.NewGetInputAudioMonitorTypeParams()
+		params = withInput(params, cmd.InputName)
+		resp, err := ctx.Client.Inputs.GetInputAudioMonitorType(params)
+		if err != nil {
+			return fmt.Errorf("failed to get input audio monitor type: %w", err)
+		}
+
+		fmt.Fprintf(ctx.Out, "Audio monitor type of input %s is %s\n",
+			ctx.Style.Highlight(cmd.InputName), monitorTypeName(resp.MonitorType))
+		return nil
+	}
+
+	params := inputs.NewSetInputAudioMonitorTypeParams().
+		WithMonitorType(monitorTypes[cmd.MonitorType])
+	params = withInput(params, cmd.InputName)
+	_, err = ctx.Client.Inputs.SetInputAudioMonitorType(params)
+	if err != nil {
+		return fmt.Errorf("failed to set input audio monitor type: %w", err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Set audio monitor type of input %s to %s\n",
+		ctx.Style.Highlight(cmd.InputName), cmd.MonitorType)
+	return nil
+}
+
+// InputAudioTracksCmd provides a command to get or set the audio tracks of an input.
+type InputAudioTracksCmd struct {
+	InputName string   `arg:"" help:"Name or UUID of the input, all inputs if omitted." optional:""`
+	Tracks    []string `arg:"" help:"Tracks to set as track=on|off (e.g. 1=on 2=off)."  optional:""`
+}
+
+// Run executes the command to get or set the audio tracks of an input.
+func (cmd *InputAudioTracksCmd) Run(ctx *context) error {
+	// The input name is optional, so tracks given without one are taken for the name
+	if _, err := parseAudioTracks([]string{cmd.InputName}); err == nil {
+		return fmt.Errorf("an input name is required to set audio tracks, e.g. input audio tracks 'Mic/Aux' %s",
+			strings.Join(append([]string{cmd.InputName}, cmd.Tracks...), " "))
+	}
+
+	tracks, err := parseAudioTracks(cmd.Tracks)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if cmd.InputName == "" {
+		resp, err := ctx.Client.Inputs.GetInputList(inputs.NewGetInputListParams())
+		if err != nil {
+			return fmt.Errorf("failed to get input list: %w", err)
+		}
+		for _, input := range resp.Inputs {
+			names = append(names, input.InputName)
+		}
+		sort.Strings(names)
+	} else {
+		name, err := resolveInputName(ctx, cmd.InputName)
+		if err != nil {
+			return err
+		}
+		cmd.InputName = name
+		names = []string{cmd.InputName}
+	}
+
+	if len(tracks) > 0 {
+		if cmd.InputName == "" {
+			return fmt.Errorf("an input name is required to set audio tracks")
+		}
+
+		params := inputs.NewSetInputAudioTracksParams().WithInputAudioTracks(&tracks)
+		params = withInput(params, cmd.InputName)
+		_, err = ctx.Client.Inputs.SetInputAudioTracks(params)
+		if err != nil {
+			return fmt.Errorf("failed to set input audio tracks: %w", err)
+		}
+	}
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border))
+	headers := []string{"Input Name"}
+	for track := 1; track <= audioTrackCount; track++ {
+		headers = append(headers, strconv.Itoa(track))
+	}
+	t.Headers(headers...)
+	t.StyleFunc(func(row, col int) lipgloss.Style {
+		style := lipgloss.NewStyle().Padding(0, 2)
+		if col == 0 {
+			style = style.Align(lipgloss.Left)
+		} else {
+			style = style.Align(lipgloss.Center)
+		}
+		switch {
+		case row == table.HeaderRow:
+			style = style.Bold(true).Align(lipgloss.Center)
+		case row%2 == 0:
+			style = style.Foreground(ctx.Style.evenRows)
+		default:
+			style = style.Foreground(ctx.Style.oddRows)
+		}
+		return style
+	})
+
+	for _, name := range names {
+		params := inputs.NewGetInputAudioTracksParams()
+		params = withInput(params, name)
+		resp, err := ctx.Client.Inputs.GetInputAudioTracks(params)
+		if err != nil {
+			if cmd.InputName == "" {
+				// Inputs without audio have no tracks
+				continue
+			}
+			return fmt.Errorf("failed to get input audio tracks: %w", err)
+		}
+
+		row := []string{name}
+		for track := 1; track <= audioTrackCount; track++ {
+			row = append(row, getEnabledMark((*resp.InputAudioTracks)[strconv.Itoa(track)]))
+		}
+		t.Row(row...)
+	}
+
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// parseAudioTracks parses track=on|off arguments into OBS audio tracks.
+func parseAudioTracks(args []string) (typedefs.InputAudioTracks, error) {
+	tracks := make(typedefs.InputAudioTracks, len(args))
+	for _, arg := range args {
+		track, state, ok := strings.Cut(arg, "=")
+		n, err := strconv.Atoi(track)
+		if !ok || err != nil || n < 1 || n > audioTrackCount {
+			return nil, fmt.Errorf(
+				"invalid track %q: expected N=on or N=off where N is 1 to %d",
+				arg,
+				audioTrackCount,
+			)
+		}
+
+		// Normalise the track number, so 01=on sets track 1
+		switch strings.ToLower(state) {
+		case "on", "true", "1":
+			tracks[strconv.Itoa(n)] = true
+		case "off", "false", "0":
+			tracks[strconv.Itoa(n)] = false
+		default:
+			return nil, fmt.Errorf("invalid track state %q: expected on or off", state)
+		}
+	}
+	return tracks, nil
+}
+
+// InputAudioVolumeCmd provides a command to get or set the volume of an input in dB or as a multiplier.
+type InputAudioVolumeCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input."`
+
+	DB  *numberValue `flag:"" help:"Volume in decibels (-100.0 to 26.0)."  xor:"volume" name:"db" placeholder:"DB"`
+	Mul *float64     `flag:"" help:"Volume as a multiplier (0.0 to 20.0)." xor:"volume"`
+}
+
+// Run executes the command to get or set the volume of an input.
+func (cmd *InputAudioVolumeCmd) Run(ctx *context) error {
+	if cmd.DB != nil && (*cmd.DB < -100.0 || *cmd.DB > 26.0) {
+		return fmt.Errorf("volume must be between -100.0 and 26.0 dB")
+	}
+	if cmd.Mul != nil && (*cmd.Mul < 0.0 || *cmd.Mul > 20.0) {
+		return fmt.Errorf("volume multiplier must be between 0.0 and 20.0")
+	}
+
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	if cmd.DB != nil || cmd.Mul != nil {
+		params := inputs.NewSetInputVolumeParams()
+		if cmd.DB != nil {
+			params = params.WithInputVolumeDb(float64(*cmd.DB))
+		} else {
+			params = params.WithInputVolumeMul(*cmd.Mul)
+		}
+		params = withInput(params, cmd.InputName)
+		_, err = ctx.Client.Inputs.SetInputVolume(params)
+		if err != nil {
+			return fmt.Errorf("failed to set input volume: %w", err)
+		}
+	}
+
+	params := inputs.NewGetInputVolumeParams()
+	params = withInput(params, cmd.InputName)
+	resp, err := ctx.Client.Inputs.GetInputVolume(params)
+	if err != nil {
+		return fmt.Errorf("failed to get input volume: %w", err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Volume of input %s is %.1f dB (%.3f)\n",
+		ctx.Style.Highlight(cmd.InputName), resp.InputVolumeDb, resp.InputVolumeMul)
+	return nil
+}
+
+// dbToMul converts a volume in decibels to a multiplier.
+func dbToMul(db float64) float64 {
+	if db <= minVolumeDb {
+		return 0
+	}
+	return math.Pow(10, db/20)
+}
+
+// mulToDb converts a volume multiplier to decibels, clamped to minVolumeDb.
+func mulToDb(mul float64) float64 {
+	if mul <= 0 {
+		return minVolumeDb
+	}
+	return max(20*math.Log10(mul), minVolumeDb)
+}
+
+// peakDb returns the loudest peak across all channels of an input volume meter, in decibels.
+func peakDb(levels [][3]float64) float64 {
+	peak := 0.0
+	for _, channel := range levels {
+		peak = max(peak, channel[1])
+	}
+	return mulToDb(peak)
+}
+
+// InputFadeCmd provides a command to fade the volume of an input.
+type InputFadeCmd struct {
+	InputName string `arg:"" help:"Name or UUID of the input to fade."`
+
+	To       numberValue   `flag:"" help:"Target volume in dB (-100.0 to 26.0)."                    required:"" placeholder:"DB"`
+	Duration time.Duration `flag:"" help:"Duration of the fade."                                                                 default:"1s"`
+	Curve    string        `flag:"" help:"Fade curve, log fades evenly in dB, linear in amplitude."                              default:"log" enum:"log,linear"`
+}
+
+// Run executes the command to fade the volume of an input.
+func (cmd *InputFadeCmd) Run(ctx *context) error {
+	if cmd.To < minVolumeDb || cmd.To > 26.0 {
+		return fmt.Errorf("volume must be between -100.0 and 26.0 dB")
+	}
+
+	name, err := resolveInputName(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	cmd.InputName = name
+
+	getParams := inputs.NewGetInputVolumeParams()
+	getParams = withInput(getParams, cmd.InputName)
+	resp, err := ctx.Client.Inputs.GetInputVolume(getParams)
+	if err != nil {
+		return fmt.Errorf("failed to get input volume: %w", err)
+	}
+	fromDb := max(resp.InputVolumeDb, minVolumeDb)
+	fromMul := resp.InputVolumeMul
+
+	err = animate(cmd.Duration, fadeInterval, "linear", func(progress float64) error {
+		params := inputs.NewSetInputVolumeParams()
+		if cmd.Curve == "linear" {
+			params = params.WithInputVolumeMul(lerp(fromMul, dbToMul(float64(cmd.To)), progress))
+		} else {
+			params = params.WithInputVolumeDb(lerp(fromDb, float64(cmd.To), progress))
+		}
+		params = withInput(params, cmd.InputName)
+		_, err := ctx.Client.Inputs.SetInputVolume(params)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set input volume: %w", err)
+	}
+
+	fmt.Fprintf(ctx.Out, "Faded volume of input %s from %.1f dB to %.1f dB\n",
+		ctx.Style.Highlight(cmd.InputName), fromDb, cmd.To)
+	return nil
+}
+
+// InputDuckCmd provides a command to lower the volume of an input while another input is active.
+// It runs until interrupted, then restores the original volume.
+type InputDuckCmd struct {
+	InputName string `arg:"" help:"Name of the input to lower."`
+
+	While     string        `flag:"" help:"Name of the input which triggers ducking."                  required:""`
+	By        float64       `flag:"" help:"Amount to lower the volume by in dB."                                   default:"12"`
+	Threshold numberValue   `flag:"" help:"Peak level in dB above which the trigger input is active."              default:"-40"`
+	Attack    time.Duration `flag:"" help:"Time taken to lower the volume."                                        default:"200ms"`
+	Hold      time.Duration `flag:"" help:"Time to stay lowered after the trigger input falls silent."             default:"500ms"`
+	Release   time.Duration `flag:"" help:"Time taken to restore the volume."                                      default:"1s"`
+}
+
+// eventSubscriptions subscribes to the high-volume InputVolumeMeters event used to detect activity.
+func (cmd *InputDuckCmd) eventSubscriptions() int {
+	return subscriptions.All | subscriptions.InputVolumeMeters
+}
+
+// Run executes the command to duck an input while another input is active.
+func (cmd *InputDuckCmd) Run(ctx *context) (err error) {
+	resp, err := ctx.Client.Inputs.GetInputList(inputs.NewGetInputListParams())
+	if err != nil {
+		return fmt.Errorf("failed to get input list: %w", err)
+	}
+	names := make([]string, 0, len(resp.Inputs))
+	for _, input := range resp.Inputs {
+		names = append(names, input.InputName)
+	}
+	if cmd.InputName, err = resolveName(ctx, "input", cmd.InputName, names); err != nil {
+		return err
+	}
+	if cmd.While, err = resolveName(ctx, "input", cmd.While, names); err != nil {
+		return err
+	}
+
+	vresp, err := ctx.Client.Inputs.GetInputVolume(
+		inputs.NewGetInputVolumeParams().WithInputName(cmd.InputName),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get input volume: %w", err)
+	}
+	base := max(vresp.InputVolumeDb, minVolumeDb)
+
+	setVolume := func(db float64) error {
+		_, err := ctx.Client.Inputs.SetInputVolume(inputs.NewSetInputVolumeParams().
+			WithInputName(cmd.InputName).
+			WithInputVolumeDb(max(db, minVolumeDb)))
+		if err != nil {
+			return fmt.Errorf("failed to set input volume: %w", err)
+		}
+		return nil
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Ducking input %s by %.1f dB while %s is above %.1f dB. Press Ctrl+C to stop.\n",
+		ctx.Style.Highlight(cmd.InputName),
+		cmd.By,
+		ctx.Style.Highlight(cmd.While),
+		cmd.Threshold,
+	)
+
+	// Restore the volume however ducking stops, so the input is not left lowered
+	defer func() {
+		if restoreErr := setVolume(base); restoreErr != nil {
+			err = errors.Join(err, restoreErr)
+			return
+		}
+		fmt.Fprintf(ctx.Out, "Restored volume of input %s to %.1f dB\n",
+			ctx.Style.Highlight(cmd.InputName), base)
+	}()
+
+	var gain float64
+	var lastActive time.Time
+	last := time.Now()
+	for {
+		select {
+		case <-interrupt:
+			return nil
+
+		case event, ok := <-ctx.Client.IncomingEvents:
+			if !ok {
+				return errors.New("connection to OBS closed")
+			}
+			meters, ok := event.(*events.InputVolumeMeters)
+			if !ok {
+				continue
+			}
+
+			now := time.Now()
+			elapsed := now.Sub(last)
+			last = now
+
+			// Inputs producing no audio are omitted from the event
+			for _, meter := range meters.Inputs {
+				if meter.Name == cmd.While && peakDb(meter.Levels) > float64(cmd.Threshold) {
+					lastActive = now
+				}
+			}
+			ducking := !lastActive.IsZero() && now.Sub(lastActive) <= cmd.Hold
+
+			next := duckGain(gain, cmd.By, ducking, elapsed, cmd.Attack, cmd.Release)
+			if next != gain {
+				gain = next
+				if err := setVolume(base - gain); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// duckGain moves the current attenuation towards by while ducking, or towards 0 otherwise,
+// at a rate which covers the full range in attack or release respectively.
+func duckGain(
+	gain, by float64,
+	ducking bool,
+	elapsed, attack, release time.Duration,
+) float64 {
+	if ducking {
+		if attack <= 0 {
+			return by
+		}
+		return min(gain+by*float64(elapsed)/float64(attack), by)
+	}
+	if release <= 0 {
+		return 0
+	}
+	return max(gain-by*float64(elapsed)/float64(release), 0)
+}
+
+// meterFloorDb is the level shown as an empty meter.
+const meterFloorDb = -60.0
+
+// clipHold is how long the clipping indicator stays lit after an input clips.
+const clipHold = time.Second
+
+// InputMeterCmd provides a command to display live audio levels of inputs.
+// It runs until interrupted.
+type InputMeterCmd struct {
+	InputNames []string `arg:"" help:"Names of the inputs to meter, all inputs producing audio if omitted." optional:""`
+
+	Width int `flag:"" help:"Width of the meter bars." default:"40"`
+}
+
+// eventSubscriptions subscribes to the high-volume InputVolumeMeters event.
+func (cmd *InputMeterCmd) eventSubscriptions() int {
+	return subscriptions.All | subscriptions.InputVolumeMeters
+}
+
+// Run executes the command to display live audio levels of inputs.
+func (cmd *InputMeterCmd) Run(ctx *context) error {
+	if cmd.Width < 10 {
+		return fmt.Errorf("width must be at least 10")
+	}
+
+	if len(cmd.InputNames) > 0 {
+		resp, err := ctx.Client.Inputs.GetInputList(inputs.NewGetInputListParams())
+		if err != nil {
+			return fmt.Errorf("failed to get input list: %w", err)
+		}
+		names := make([]string, 0, len(resp.Inputs))
+		for _, input := range resp.Inputs {
+			names = append(names, input.InputName)
+		}
+		for i, name := range cmd.InputNames {
+			if cmd.InputNames[i], err = resolveName(ctx, "input", name, names); err != nil {
+				return err
+			}
+		}
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	// Only redraw in place on a terminal, elsewhere each update is printed as plain lines
+	redraw := isTerminal(ctx.Out)
+	clipped := make(map[string]time.Time)
+	var lines int
+	for {
+		select {
+		case <-interrupt:
+			return nil
+
+		case event, ok := <-ctx.Client.IncomingEvents:
+			if !ok {
+				return errors.New("connection to OBS closed")
+			}
+			meters, ok := event.(*events.InputVolumeMeters)
+			if !ok {
+				continue
+			}
+
+			levels := make(map[string][][3]float64, len(meters.Inputs))
+			for _, meter := range meters.Inputs {
+				levels[meter.Name] = meter.Levels
+			}
+
+			names := cmd.InputNames
+			if len(names) == 0 {
+				names = slices.Sorted(maps.Keys(levels))
+			}
+
+			now := time.Now()
+			var frame strings.Builder
+			for _, name := range names {
+				if peakDb(levels[name]) >= 0 {
+					clipped[name] = now
+				}
+				isClipped := now.Sub(clipped[name]) < clipHold
+				if !redraw {
+					frame.WriteString(plainMeter(name, levels[name], isClipped))
+					continue
+				}
+				frame.WriteString(renderMeter(ctx, name, levels[name], cmd.Width, isClipped))
+			}
+
+			if !redraw {
+				fmt.Fprint(ctx.Out, frame.String())
+				continue
+			}
+			// Redraw the previous frame in place
+			if lines > 0 {
+				fmt.Fprintf(ctx.Out, "\x1b[%dA\x1b[J", lines)
+			}
+			fmt.Fprint(ctx.Out, frame.String())
+			lines = strings.Count(frame.String(), "\n")
+		}
+	}
+}
+
+// renderMeter renders a line per channel of an input, showing magnitude as a solid bar,
+// peak as a shaded bar beyond it, and a clipping indicator.
+func renderMeter(ctx *context, name string, levels [][3]float64, width int, clipped bool) string {
+	var b strings.Builder
+
+	fmt.Fprint(&b, ctx.Style.Highlight(name))
+	if clipped {
+		fmt.Fprintf(&b, " %s", ctx.Style.Error("CLIP"))
+	}
+	fmt.Fprintln(&b)
+
+	if len(levels) == 0 {
+		fmt.Fprintf(&b, "  %s\n", lipgloss.NewStyle().Foreground(ctx.Style.border).Render("no signal"))
+		return b.String()
+	}
+
+	magnitudeStyle := lipgloss.NewStyle().Foreground(ctx.Style.evenRows)
+	peakStyle := lipgloss.NewStyle().Foreground(ctx.Style.oddRows)
+	emptyStyle := lipgloss.NewStyle().Foreground(ctx.Style.border)
+	for i, channel := range levels {
+		magnitude, peak := meterPositions(mulToDb(channel[0]), mulToDb(channel[1]), width)
+		bar := magnitudeStyle.Render(strings.Repeat("█", magnitude)) +
+			peakStyle.Render(strings.Repeat("▒", peak-magnitude)) +
+			emptyStyle.Render(strings.Repeat("░", width-peak))
+		fmt.Fprintf(&b, "  %-2s %s %6.1f dB\n", channelLabel(i, len(levels)), bar, mulToDb(channel[1]))
+	}
+	return b.String()
+}
+
+// plainMeter renders the levels of an input as a single line of text without styling, for output
+// which is not a terminal.
+func plainMeter(name string, levels [][3]float64, clipped bool) string {
+	var b strings.Builder
+
+	fmt.Fprint(&b, name)
+	if len(levels) == 0 {
+		fmt.Fprint(&b, " no signal")
+	}
+	for i, channel := range levels {
+		fmt.Fprintf(&b, " %s %.1f dB", channelLabel(i, len(levels)), mulToDb(channel[1]))
+	}
+	if clipped {
+		fmt.Fprint(&b, " CLIP")
+	}
+	fmt.Fprintln(&b)
+	return b.String()
+}
+
+// meterPositions returns how many cells of a meter of the given width the magnitude and peak fill.
+// The meter spans meterFloorDb to 0 dB, and peak never falls below magnitude.
+func meterPositions(magnitudeDb, peakDb float64, width int) (int, int) {
+	cells := func(db float64) int {
+		fraction := (min(max(db, meterFloorDb), 0) - meterFloorDb) / -meterFloorDb
+		return int(math.Round(fraction * float64(width)))
+	}
+
+	magnitude := cells(magnitudeDb)
+	return magnitude, max(cells(peakDb), magnitude)
+}
+
+// channelLabel returns a label for channel i of count channels, L and R for stereo inputs.
+func channelLabel(i, count int) string {
+	if count == 2 {
+		return []string{"L", "R"}[i]
+	}
+	return strconv.Itoa(i + 1)
+}
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/andreykaipov/goobs/api/typedefs"
+)
+
+func TestInputAudioBalance(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	balance := 0.25
+	cmdSet := &InputAudioBalanceCmd{InputName: "Mic/Aux", Balance: &balance}
+	err := cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to set input audio balance: %v", err)
+	}
+	out.Reset()
+
+	cmdGet := &InputAudioBalanceCmd{InputName: "Mic/Aux"}
+	err = cmdGet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get input audio balance: %v", err)
+	}
+	if out.String() != "Audio balance of input Mic/Aux is 0.25\n" {
+		t.Fatalf("Expected output to be 'Audio balance of input Mic/Aux is 0.25', got '%s'", out.String())
+	}
+
+	balance = 0.5
+	err = cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore input audio balance: %v", err)
+	}
+}
+
+func TestInputAudioMonitor(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdSet := &InputAudioMonitorCmd{InputName: "Mic/Aux", MonitorType: "monitor-only"}
+	err := cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to set input audio monitor type: %v", err)
+	}
+	out.Reset()
+
+	cmdGet := &InputAudioMonitorCmd{InputName: "Mic/Aux"}
+	err = cmdGet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get input audio monitor type: %v", err)
+	}
+	if out.String() != "Audio monitor type of input Mic/Aux is monitor-only\n" {
+		t.Fatalf(
+			"Expected output to be 'Audio monitor type of input Mic/Aux is monitor-only', got '%s'",
+			out.String(),
+		)
+	}
+
+	cmdSet.MonitorType = "none"
+	err = cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore input audio monitor type: %v", err)
+	}
+}
+
+func TestInputAudioVolume(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	mul := 1.0
+	cmd := &InputAudioVolumeCmd{InputName: "Mic/Aux", Mul: &mul}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to set input volume: %v", err)
+	}
+	if out.String() != "Volume of input Mic/Aux is 0.0 dB (1.000)\n" {
+		t.Fatalf("Expected output to be 'Volume of input Mic/Aux is 0.0 dB (1.000)', got '%s'", out.String())
+	}
+}
+
+func TestParseAudioTracks(t *testing.T) {
+	tracks, err := parseAudioTracks([]string{"1=on", "02=off", "6=true"})
+	if err != nil {
+		t.Fatalf("Failed to parse audio tracks: %v", err)
+	}
+
+	expected := typedefs.InputAudioTracks{"1": true, "2": false, "6": true}
+	if len(tracks) != len(expected) {
+		t.Fatalf("Expected %v but got %v", expected, tracks)
+	}
+	for track, enabled := range expected {
+		if tracks[track] != enabled {
+			t.Errorf("Expected track %s to be %v but got %v", track, enabled, tracks[track])
+		}
+	}
+}
+
+func TestParseAudioTracksInvalid(t *testing.T) {
+	for _, arg := range []string{"1", "0=on", "7=on", "x=on", "1=maybe"} {
+		if _, err := parseAudioTracks([]string{arg}); err == nil {
+			t.Errorf("Expected an error parsing %q", arg)
+		}
+	}
+}
+
+func TestInputAudioTracksMissingName(t *testing.T) {
+	var out bytes.Buffer
+	context := newContext(nil, &out, StyleConfig{})
+
+	// The tracks are checked before any request is made, so no connection to OBS is needed
+	cmd := &InputAudioTracksCmd{InputName: "1=on", Tracks: []string{"2=off"}}
+	err := cmd.Run(context)
+	if err == nil {
+		t.Fatal("Expected an error for tracks without an input name")
+	}
+	if !strings.Contains(err.Error(), "an input name is required") {
+		t.Fatalf("Expected error to ask for an input name, got '%s'", err.Error())
+	}
+}
+
+func TestInputAudioNegativeValuesParseArgs(t *testing.T) {
+	var cli struct {
+		Sync   InputAudioSyncCmd   `cmd:""`
+		Volume InputAudioVolumeCmd `cmd:""`
+	}
+	parser, err := kong.New(&cli)
+	if err != nil {
+		t.Fatalf("Failed to create parser: %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"sync", "Mic/Aux", "-500"}); err != nil {
+		t.Fatalf("Failed to parse sync: %v", err)
+	}
+	if cli.Sync.InputName != "Mic/Aux" || cli.Sync.Offset == nil || *cli.Sync.Offset != -500 {
+		t.Errorf("Expected Mic/Aux and -500, got %s and %v", cli.Sync.InputName, cli.Sync.Offset)
+	}
+
+	if _, err := parser.Parse([]string{"sync", "Mic/Aux"}); err != nil {
+		t.Fatalf("Failed to parse sync: %v", err)
+	}
+	if cli.Sync.Offset != nil {
+		t.Errorf("Expected no offset, got %v", *cli.Sync.Offset)
+	}
+
+	if _, err := parser.Parse([]string{"volume", "Mic/Aux", "--db", "-10"}); err != nil {
+		t.Fatalf("Failed to parse volume: %v", err)
+	}
+	if cli.Volume.DB == nil || *cli.Volume.DB != -10 {
+		t.Errorf("Expected --db -10, got %v", cli.Volume.DB)
+	}
+
+	if _, err := parser.Parse([]string{"sync", "Mic/Aux", "-x"}); err == nil {
+		t.Error("Expected error for an unknown flag, but got none")
+	}
+}
+
+func TestMonitorTypeName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"OBS_MONITORING_TYPE_NONE", "none"},
+		{"OBS_MONITORING_TYPE_MONITOR_AND_OUTPUT", "monitor-and-output"},
+		{"OBS_MONITORING_TYPE_UNKNOWN", "OBS_MONITORING_TYPE_UNKNOWN"},
+	}
+
+	for _, test := range tests {
+		result := monitorTypeName(test.input)
+		if result != test.expected {
+			t.Errorf("Expected '%s' but got '%s'", test.expected, result)
+		}
+	}
+}
+
+func TestInputFade(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &InputFadeCmd{
+		InputName: "Mic/Aux",
+		To:        -10,
+		Duration:  200 * time.Millisecond,
+		Curve:     "log",
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to fade input: %v", err)
+	}
+	if !strings.HasSuffix(out.String(), "to -10.0 dB\n") {
+		t.Fatalf("Expected output to end with 'to -10.0 dB', got '%s'", out.String())
+	}
+
+	cmd.To = 0
+	cmd.Curve = "linear"
+	err = cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to restore input volume: %v", err)
+	}
+}
+
+func TestVolumeConversions(t *testing.T) {
+	tests := []struct {
+		db  float64
+		mul float64
+	}{
+		{0, 1},
+		{-20, 0.1},
+		{20, 10},
+		{minVolumeDb, 0},
+	}
+
+	for _, test := range tests {
+		if got := dbToMul(test.db); math.Abs(got-test.mul) > 1e-9 {
+			t.Errorf("Expected dbToMul(%v) to be %v but got %v", test.db, test.mul, got)
+		}
+		if got := mulToDb(test.mul); math.Abs(got-test.db) > 1e-9 {
+			t.Errorf("Expected mulToDb(%v) to be %v but got %v", test.mul, test.db, got)
+		}
+	}
+}
+
+func TestPeakDb(t *testing.T) {
+	levels := [][3]float64{
+		{0.05, 0.1, 0.1},
+		{0.2, 1, 1},
+	}
+	if got := peakDb(levels); math.Abs(got) > 1e-9 {
+		t.Errorf("Expected peak of 0 dB but got %v", got)
+	}
+	if got := peakDb(nil); got != minVolumeDb {
+		t.Errorf("Expected peak of silence to be %v but got %v", minVolumeDb, got)
+	}
+}
+
+func TestDuckGain(t *testing.T) {
+	tests := []struct {
+		name     string
+		gain     float64
+		ducking  bool
+		elapsed  time.Duration
+		expected float64
+	}{
+		{"attack", 0, true, 100 * time.Millisecond, 6},
+		{"attack clamps", 10, true, 100 * time.Millisecond, 12},
+		{"release", 12, false, 500 * time.Millisecond, 6},
+		{"release clamps", 2, false, 500 * time.Millisecond, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := duckGain(test.gain, 12, test.ducking, test.elapsed, 200*time.Millisecond, time.Second)
+			if math.Abs(got-test.expected) > 1e-9 {
+				t.Errorf("Expected gain of %v but got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestMeterPositions(t *testing.T) {
+	tests := []struct {
+		magnitudeDb, peakDb   float64
+		wantMagnitude, wantPk int
+	}{
+		{minVolumeDb, minVolumeDb, 0, 0},
+		{-30, -15, 20, 30},
+		{0, 6, 40, 40},
+		{-15, -30, 30, 30},
+	}
+
+	for _, test := range tests {
+		magnitude, peak := meterPositions(test.magnitudeDb, test.peakDb, 40)
+		if magnitude != test.wantMagnitude || peak != test.wantPk {
+			t.Errorf("Expected meterPositions(%v, %v) to be %d, %d but got %d, %d",
+				test.magnitudeDb, test.peakDb, test.wantMagnitude, test.wantPk, magnitude, peak)
+		}
+	}
+}
+
+func TestChannelLabel(t *testing.T) {
+	if got := channelLabel(1, 2); got != "R" {
+		t.Errorf("Expected 'R' but got '%s'", got)
+	}
+	if got := channelLabel(4, 6); got != "5" {
+		t.Errorf("Expected '5' but got '%s'", got)
+	}
+}
+
+func TestPlainMeter(t *testing.T) {
+	got := plainMeter("Mic/Aux", [][3]float64{{0.1, 0.5, 0.5}, {0.1, 1, 1}}, true)
+	if got != "Mic/Aux L -6.0 dB R 0.0 dB CLIP\n" {
+		t.Errorf("Expected 'Mic/Aux L -6.0 dB R 0.0 dB CLIP' but got '%s'", got)
+	}
+	if got := plainMeter("Mic/Aux", nil, false); got != "Mic/Aux no signal\n" {
+		t.Errorf("Expected 'Mic/Aux no signal' but got '%s'", got)
+	}
+}
+
+func TestNegativeNumberFlagsParseArgs(t *testing.T) {
+	var cli struct {
+		Fade InputFadeCmd `cmd:""`
+		Duck InputDuckCmd `cmd:""`
+	}
+	parser, err := kong.New(&cli)
+	if err != nil {
+		t.Fatalf("Failed to create parser: %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"fade", "Music", "--to", "-30", "--duration", "2s"}); err != nil {
+		t.Fatalf("Failed to parse fade: %v", err)
+	}
+	if cli.Fade.To != -30 || cli.Fade.Duration != 2*time.Second {
+		t.Errorf("Expected --to -30 and --duration 2s, got %v and %v", cli.Fade.To, cli.Fade.Duration)
+	}
+
+	if _, err := parser.Parse([]string{"duck", "Music", "--while", "Mic/Aux"}); err != nil {
+		t.Fatalf("Failed to parse duck: %v", err)
+	}
+	if cli.Duck.Threshold != -40 {
+		t.Errorf("Expected the default threshold of -40, got %v", cli.Duck.Threshold)
+	}
+	if _, err := parser.Parse([]string{"duck", "Music", "--threshold", "-30", "--while", "Mic/Aux"}); err != nil {
+		t.Fatalf("Failed to parse duck: %v", err)
+	}
+	if cli.Duck.Threshold != -30 {
+		t.Errorf("Expected --threshold -30, got %v", cli.Duck.Threshold)
+	}
+
+	if _, err := parser.Parse([]string{"fade", "Music", "--to", "-x"}); err == nil {
+		t.Error("Expected error for a flag in place of a value, but got none")
+	}
+}
@@ -55,6 +55,8 @@ type CLI struct {
 	Man     mangokong.ManFlag `help:"Print man page."`
 	Version VersionFlag       `help:"Print gobs-cli version information and quit" name:"version" short:"v"`
 
+	Fuzzy bool `help:"Accept a unique case-insensitive or prefix match for names." env:"GOBS_FUZZY"`
+
 	Completion kongcompletion.Completion `help:"Generate shell completion scripts." cmd:""`
 
 	ObsVersion      ObsVersionCmd      `cmd:"" help:"Print OBS client and websocket version." aliases:"v"   completion-enabled-command-alias:"false"`
@@ -78,10 +80,17 @@ type CLI struct {
 	Media           MediaCmd           `cmd:"" help:"Manage media inputs."                    aliases:"mi"  completion-enabled-command-alias:"false" group:"Media Input"`
 }
 
+// eventSubscriber is implemented by commands which need events beyond the default subscriptions,
+// such as the high-volume InputVolumeMeters event.
+type eventSubscriber interface {
+	eventSubscriptions() int
+}
+
 type context struct {
 	Client *goobs.Client
 	Out    io.Writer
 	Style  *Style
+	Fuzzy  bool
 }
 
 func newContext(client *goobs.Client, out io.Writer, styleCfg StyleConfig) *context {
@@ -129,18 +138,25 @@ func main() {
 		},
 	)
 
-	ctx.FatalIfErrorf(run(ctx, cli.ObsConfig, cli.StyleConfig))
+	ctx.FatalIfErrorf(run(ctx, cli.ObsConfig, cli.StyleConfig, cli.Fuzzy))
 }
 
 // run executes the command line interface.
 // It connects to the OBS WebSocket server and binds the context to the selected command.
 // It also handles the "completion" command separately to avoid unnecessary connections.
-func run(ctx *kong.Context, obsCfg ObsConfig, styleCfg StyleConfig) error {
+func run(ctx *kong.Context, obsCfg ObsConfig, styleCfg StyleConfig, fuzzy bool) error {
 	if ctx.Selected().Name == "completion" {
 		return ctx.Run()
 	}
 
-	client, err := connectObs(obsCfg)
+	var opts []goobs.Option
+	if target := ctx.Selected().Target; target.CanAddr() {
+		if cmd, ok := target.Addr().Interface().(eventSubscriber); ok {
+			opts = append(opts, goobs.WithEventSubscriptions(cmd.eventSubscriptions()))
+		}
+	}
+
+	client, err := connectObs(obsCfg, opts...)
 	if err != nil {
 		return err
 	}
@@ -152,20 +168,46 @@ func run(ctx *kong.Context, obsCfg ObsConfig, styleCfg StyleConfig) error {
 		return nil
 	}() // nolint: errcheck
 
-	ctx.Bind(newContext(client, os.Stdout, styleCfg))
+	cmdCtx := newContext(client, os.Stdout, styleCfg)
+	cmdCtx.Fuzzy = fuzzy
+	ctx.Bind(cmdCtx)
 
 	return ctx.Run()
 }
 
 // connectObs creates a new OBS client and connects to the OBS WebSocket server.
-func connectObs(cfg ObsConfig) (*goobs.Client, error) {
+// Additional options, such as event subscriptions, may be passed in opts.
+func connectObs(cfg ObsConfig, opts ...goobs.Option) (*goobs.Client, error) {
 	client, err := goobs.New(
 		fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
-		goobs.WithPassword(cfg.Password),
-		goobs.WithResponseTimeout(time.Duration(cfg.Timeout)*time.Second),
+		append([]goobs.Option{
+			goobs.WithPassword(cfg.Password),
+			goobs.WithResponseTimeout(time.Duration(cfg.Timeout) * time.Second),
+		}, opts...)...,
 	)
 	if err != nil {
 		return nil, err
 	}
 	return client, nil
 }
+
+// parseCommandLine parses args as a gobs-cli command line, to be run later with runCommandLine.
+func parseCommandLine(ctx *context, args []string) (*kong.Context, error) {
+	var cli CLI
+	parser, err := kong.New(&cli, kong.Name("gobs-cli"), kong.Exit(func(int) {}))
+	if err != nil {
+		return nil, err
+	}
+	kctx, err := parser.Parse(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse command %s: %w", ctx.Style.Error(strings.Join(args, " ")), err)
+	}
+	return kctx, nil
+}
+
+// runCommandLine runs the command selected by a command line from parseCommandLine with ctx,
+// reusing its connection to OBS. Connection flags in the command line are ignored.
+func runCommandLine(ctx *context, kctx *kong.Context) error {
+	kctx.Bind(ctx)
+	return kctx.Run()
+}
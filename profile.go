@@ -90,6 +90,11 @@ func (cmd *ProfileSwitchCmd) Run(ctx *context) error {
 	}
 	current := profiles.CurrentProfileName
 
+	cmd.Name, err = resolveName(ctx, "profile", cmd.Name, profiles.Profiles)
+	if err != nil {
+		return err
+	}
+
 	if current == cmd.Name {
 		return fmt.Errorf("already using profile %s", ctx.Style.Error(cmd.Name))
 	}
@@ -151,8 +156,9 @@ func (cmd *ProfileRemoveCmd) Run(ctx *context) error {
 		return err
 	}
 
+	// Fuzzy matching is not applied here, a profile is only deleted by its exact name
 	if !slices.Contains(profiles.Profiles, cmd.Name) {
-		return fmt.Errorf("profile %s does not exist", ctx.Style.Error(cmd.Name))
+		return notFoundError(ctx, "profile", cmd.Name, profiles.Profiles)
 	}
 
 	// Prevent deletion of the current profile
@@ -104,22 +104,32 @@ func (cmd *SceneCurrentCmd) Run(ctx *context) error {
 // SceneSwitchCmd provides a command to switch to a different scene.
 type SceneSwitchCmd struct {
 	Preview  bool   `flag:"" help:"Preview scene."`
-	NewScene string `        help:"Scene name to switch to." arg:""`
+	NewScene string `        help:"Scene name or UUID to switch to." arg:""`
 }
 
 // Run executes the command to switch to a different scene.
 func (cmd *SceneSwitchCmd) Run(ctx *context) error {
+	if !isUUID(cmd.NewScene) {
+		sceneName, err := resolveSceneName(ctx, cmd.NewScene)
+		if err != nil {
+			return err
+		}
+		cmd.NewScene = sceneName
+	}
+
 	if cmd.Preview {
-		_, err := ctx.Client.Scenes.SetCurrentPreviewScene(scenes.NewSetCurrentPreviewSceneParams().
-			WithSceneName(cmd.NewScene))
+		params := scenes.NewSetCurrentPreviewSceneParams()
+		params = withScene(params, cmd.NewScene)
+		_, err := ctx.Client.Scenes.SetCurrentPreviewScene(params)
 		if err != nil {
 			return err
 		}
 
 		fmt.Fprintf(ctx.Out, "Switched to preview scene: %s\n", ctx.Style.Highlight(cmd.NewScene))
 	} else {
-		_, err := ctx.Client.Scenes.SetCurrentProgramScene(scenes.NewSetCurrentProgramSceneParams().
-			WithSceneName(cmd.NewScene))
+		params := scenes.NewSetCurrentProgramSceneParams()
+		params = withScene(params, cmd.NewScene)
+		_, err := ctx.Client.Scenes.SetCurrentProgramScene(params)
 		if err != nil {
 			return err
 		}
@@ -128,3 +138,24 @@ func (cmd *SceneSwitchCmd) Run(ctx *context) error {
 	}
 	return nil
 }
+
+// resolveSceneName returns the name of the scene given by name or UUID.
+// Unknown names are resolved as described in resolveName.
+func resolveSceneName(ctx *context, scene string) (string, error) {
+	resp, err := ctx.Client.Scenes.GetSceneList()
+	if err != nil {
+		return "", fmt.Errorf("failed to get scene list: %w", err)
+	}
+
+	names := make([]string, 0, len(resp.Scenes))
+	for _, s := range resp.Scenes {
+		if s.SceneUuid == scene {
+			return s.SceneName, nil
+		}
+		names = append(names, s.SceneName)
+	}
+	if isUUID(scene) {
+		return "", fmt.Errorf("no scene found with UUID %s", ctx.Style.Error(scene))
+	}
+	return resolveName(ctx, "scene", scene, names)
+}
@@ -80,6 +80,11 @@ func (cmd *SceneCollectionSwitchCmd) Run(ctx *context) error {
 	}
 	current := collections.CurrentSceneCollectionName
 
+	cmd.Name, err = resolveName(ctx, "scene collection", cmd.Name, collections.SceneCollections)
+	if err != nil {
+		return err
+	}
+
 	if current == cmd.Name {
 		return fmt.Errorf("scene collection %s is already active", ctx.Style.Error(cmd.Name))
 	}
@@ -2,29 +2,42 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/andreykaipov/goobs"
 	"github.com/andreykaipov/goobs/api/requests/sceneitems"
+	"github.com/andreykaipov/goobs/api/typedefs"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 )
 
 // SceneItemCmd provides commands to manage scene items in OBS Studio.
 type SceneItemCmd struct {
-	List      SceneItemListCmd      `cmd:"" help:"List all scene items."      aliases:"ls" completion-enabled-command-alias:"false"`
-	Show      SceneItemShowCmd      `cmd:"" help:"Show scene item."           aliases:"sh" completion-enabled-command-alias:"false"`
-	Hide      SceneItemHideCmd      `cmd:"" help:"Hide scene item."           aliases:"h"  completion-enabled-command-alias:"false"`
-	Toggle    SceneItemToggleCmd    `cmd:"" help:"Toggle scene item."         aliases:"tg" completion-enabled-command-alias:"false"`
-	Visible   SceneItemVisibleCmd   `cmd:"" help:"Get scene item visibility." aliases:"v"  completion-enabled-command-alias:"false"`
-	Transform SceneItemTransformCmd `cmd:"" help:"Transform scene item."      aliases:"t"  completion-enabled-command-alias:"false"`
+	List      SceneItemListCmd      `cmd:"" help:"List all scene items."            aliases:"ls" completion-enabled-command-alias:"false"`
+	Show      SceneItemShowCmd      `cmd:"" help:"Show scene item."                 aliases:"sh" completion-enabled-command-alias:"false"`
+	Hide      SceneItemHideCmd      `cmd:"" help:"Hide scene item."                 aliases:"h"  completion-enabled-command-alias:"false"`
+	Toggle    SceneItemToggleCmd    `cmd:"" help:"Toggle scene item."               aliases:"tg" completion-enabled-command-alias:"false"`
+	Visible   SceneItemVisibleCmd   `cmd:"" help:"Get scene item visibility."       aliases:"v"  completion-enabled-command-alias:"false"`
+	Transform SceneItemTransformCmd `cmd:"" help:"Transform scene item."            aliases:"t"  completion-enabled-command-alias:"false"`
+	Animate   SceneItemAnimateCmd   `cmd:"" help:"Animate scene item transform."    aliases:"a"  completion-enabled-command-alias:"false"`
+	Layout    SceneItemLayoutCmd    `cmd:"" help:"Arrange scene items in a layout." aliases:"lo" completion-enabled-command-alias:"false"`
+	Info      SceneItemInfoCmd      `cmd:"" help:"Show scene item transform."       aliases:"i"  completion-enabled-command-alias:"false"`
+	Lock      SceneItemLockCmd      `cmd:"" help:"Lock scene item."                 aliases:"lk" completion-enabled-command-alias:"false"`
+	Unlock    SceneItemUnlockCmd    `cmd:"" help:"Unlock scene item."               aliases:"ul" completion-enabled-command-alias:"false"`
+	Locked    SceneItemLockedCmd    `cmd:"" help:"Get scene item lock state."       aliases:"ld" completion-enabled-command-alias:"false"`
+	Blend     SceneItemBlendCmd     `cmd:"" help:"Get/Set scene item blend mode."   aliases:"b"  completion-enabled-command-alias:"false"`
 }
 
 // SceneItemListCmd provides a command to list all scene items in a scene.
 type SceneItemListCmd struct {
 	UUID      bool   `flag:"" help:"Display UUIDs of scene items."`
-	SceneName string `        help:"Name of the scene to list items from." arg:"" default:""`
+	SceneName string `        help:"Name or UUID of the scene to list items from." arg:"" default:""`
 }
 
 // Run executes the command to list all scene items in a scene.
@@ -37,6 +50,12 @@ func (cmd *SceneItemListCmd) Run(ctx *context) error {
 		cmd.SceneName = currentScene.SceneName
 	}
 
+	sceneName, err := resolveSceneName(ctx, cmd.SceneName)
+	if err != nil {
+		return err
+	}
+	cmd.SceneName = sceneName
+
 	resp, err := ctx.Client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().
 		WithSceneName(cmd.SceneName))
 	if err != nil {
@@ -142,6 +161,27 @@ func (cmd *SceneItemListCmd) Run(ctx *context) error {
 	return nil
 }
 
+// resolveGroupName returns the name of a group in the scene given by name or UUID.
+// Group names are returned unchanged.
+func resolveGroupName(ctx *context, sceneName string, group string) (string, error) {
+	if !isUUID(group) {
+		return group, nil
+	}
+
+	resp, err := ctx.Client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().
+		WithSceneName(sceneName))
+	if err != nil {
+		return "", err
+	}
+	for _, item := range resp.SceneItems {
+		if item.IsGroup && item.SourceUuid == group {
+			return item.SourceName, nil
+		}
+	}
+	return "", fmt.Errorf("no group found with UUID %s in scene %s",
+		ctx.Style.Error(group), ctx.Style.Error(sceneName))
+}
+
 // getSceneNameAndItemID retrieves the scene name and item ID for a given item in a scene or group.
 func getSceneNameAndItemID(
 	ctx *context,
@@ -149,17 +189,39 @@ func getSceneNameAndItemID(
 	itemName string,
 	group string,
 ) (string, int, error) {
-	if group != "" {
-		resp, err := ctx.Client.SceneItems.GetGroupSceneItemList(
-			sceneitems.NewGetGroupSceneItemListParams().
-				WithSceneName(group),
-		)
-		if err != nil {
-			return "", 0, err
+	sceneName, err := resolveSceneName(ctx, sceneName)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if group != "" || isUUID(itemName) {
+		var items []*typedefs.SceneItem
+		container := sceneName
+		if group != "" {
+			group, err = resolveGroupName(ctx, sceneName, group)
+			if err != nil {
+				return "", 0, err
+			}
+			resp, err := ctx.Client.SceneItems.GetGroupSceneItemList(
+				sceneitems.NewGetGroupSceneItemListParams().
+					WithSceneName(group),
+			)
+			if err != nil {
+				return "", 0, err
+			}
+			items = resp.SceneItems
+			container = group
+		} else {
+			resp, err := ctx.Client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().
+				WithSceneName(sceneName))
+			if err != nil {
+				return "", 0, err
+			}
+			items = resp.SceneItems
 		}
-		for _, item := range resp.SceneItems {
-			if item.SourceName == itemName {
-				return group, int(item.SceneItemID), nil
+		for _, item := range items {
+			if item.SourceName == itemName || item.SourceUuid == itemName {
+				return container, int(item.SceneItemID), nil
 			}
 		}
 		return "", 0, fmt.Errorf(
@@ -187,48 +249,198 @@ func getSceneNameAndItemID(
 	return sceneName, int(itemID.SceneItemId), nil
 }
 
+// SceneItemSelectFlags provides flags for selecting several scene items at once.
+type SceneItemSelectFlags struct {
+	Regex  bool   `flag:"" help:"Match item names with a regular expression rather than a glob."`
+	All    bool   `flag:"" help:"Select all items in the scene or group."`
+	Except string `flag:"" help:"Exclude items matching this glob (or regular expression with --regex)."`
+}
+
+// globToRegexp converts a glob supporting * and ? into an anchored regular expression.
+func globToRegexp(glob string) string {
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+	pattern = strings.ReplaceAll(pattern, `\?`, ".")
+	return "^" + pattern + "$"
+}
+
+// compileSelector compiles a glob, or a regular expression if regex is set.
+func compileSelector(selector string, regex bool) (*regexp.Regexp, error) {
+	if regex {
+		return regexp.Compile(selector)
+	}
+	return regexp.Compile(globToRegexp(selector))
+}
+
+// selectSceneItems retrieves the items in a scene or group matching the item name and select flags.
+// It returns the name of the scene or group containing the items, the matching items and
+// all items in the scene or group.
+func selectSceneItems(
+	ctx *context,
+	sceneName string,
+	itemName string,
+	group string,
+	sel SceneItemSelectFlags,
+) (string, []*typedefs.SceneItem, []*typedefs.SceneItem, error) {
+	if itemName == "" && !sel.All {
+		return "", nil, nil, fmt.Errorf(
+			"an item name or the %s flag is required",
+			ctx.Style.Error("--all"),
+		)
+	}
+
+	var include, exclude *regexp.Regexp
+	var err error
+	if itemName != "" && !sel.All {
+		include, err = compileSelector(itemName, sel.Regex)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("invalid item selector %s: %w", ctx.Style.Error(itemName), err)
+		}
+	}
+	if sel.Except != "" {
+		exclude, err = compileSelector(sel.Except, sel.Regex)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("invalid except selector %s: %w", ctx.Style.Error(sel.Except), err)
+		}
+	}
+
+	sceneName, err = resolveSceneName(ctx, sceneName)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var items []*typedefs.SceneItem
+	container := sceneName
+	if group != "" {
+		group, err = resolveGroupName(ctx, sceneName, group)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		resp, err := ctx.Client.SceneItems.GetGroupSceneItemList(
+			sceneitems.NewGetGroupSceneItemListParams().
+				WithSceneName(group),
+		)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		items = resp.SceneItems
+		container = group
+	} else {
+		resp, err := ctx.Client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().
+			WithSceneName(sceneName))
+		if err != nil {
+			return "", nil, nil, err
+		}
+		items = resp.SceneItems
+	}
+
+	var selected []*typedefs.SceneItem
+	for _, item := range items {
+		// An exact name or UUID always matches, even if it contains glob characters
+		matched := include == nil || item.SourceName == itemName || item.SourceUuid == itemName ||
+			include.MatchString(item.SourceName)
+		if matched && (exclude == nil || !exclude.MatchString(item.SourceName)) {
+			selected = append(selected, item)
+		}
+	}
+
+	if len(selected) == 0 {
+		if group != "" {
+			return "", nil, nil, fmt.Errorf(
+				"no items matching %s found in group %s",
+				ctx.Style.Error(itemName),
+				ctx.Style.Error(group),
+			)
+		}
+		return "", nil, nil, fmt.Errorf(
+			"no items matching %s found in scene %s. are they in a group? if so use the %s flag to specify the parent group\nuse %s for a list of items in the scene",
+			ctx.Style.Error(itemName),
+			ctx.Style.Error(sceneName),
+			ctx.Style.Error("--group"),
+			ctx.Style.Error("gobs-cli si ls"),
+		)
+	}
+
+	return container, selected, items, nil
+}
+
+// setItemsEnabled sets the enabled state of each of the given scene items.
+func setItemsEnabled(
+	client *goobs.Client,
+	sceneName string,
+	items []*typedefs.SceneItem,
+	enabled bool,
+) error {
+	for _, item := range items {
+		_, err := client.SceneItems.SetSceneItemEnabled(sceneitems.NewSetSceneItemEnabledParams().
+			WithSceneName(sceneName).
+			WithSceneItemId(item.SceneItemID).
+			WithSceneItemEnabled(enabled))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SceneItemShowCmd provides a command to show a scene item.
 type SceneItemShowCmd struct {
-	Group string `flag:"" help:"Parent group name."`
+	Group     string `flag:"" help:"Parent group name or UUID."`
+	Exclusive bool   `flag:"" help:"Hide all other items in the same scene or group."`
 
-	SceneName string `arg:"" help:"Scene name."`
-	ItemName  string `arg:"" help:"Item name."`
+	SceneItemSelectFlags `embed:""`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name, UUID, glob or regular expression." optional:""`
 }
 
 // Run executes the command to show a scene item.
 func (cmd *SceneItemShowCmd) Run(ctx *context) error {
-	sceneName, sceneItemID, err := getSceneNameAndItemID(
+	sceneName, selected, items, err := selectSceneItems(
 		ctx,
 		cmd.SceneName,
 		cmd.ItemName,
 		cmd.Group,
+		cmd.SceneItemSelectFlags,
 	)
 	if err != nil {
 		return err
 	}
 
-	_, err = ctx.Client.SceneItems.SetSceneItemEnabled(sceneitems.NewSetSceneItemEnabledParams().
-		WithSceneName(sceneName).
-		WithSceneItemId(sceneItemID).
-		WithSceneItemEnabled(true))
+	err = setItemsEnabled(ctx.Client, sceneName, selected, true)
 	if err != nil {
 		return err
 	}
 
-	if cmd.Group != "" {
-		fmt.Fprintf(
-			ctx.Out,
-			"Scene item %s in group %s is now visible.\n",
-			ctx.Style.Highlight(cmd.ItemName),
-			ctx.Style.Highlight(cmd.Group),
-		)
-	} else {
-		fmt.Fprintf(
-			ctx.Out,
-			"Scene item %s in scene %s is now visible.\n",
-			ctx.Style.Highlight(cmd.ItemName),
-			ctx.Style.Highlight(cmd.SceneName),
-		)
+	if cmd.Exclusive {
+		var siblings []*typedefs.SceneItem
+		for _, item := range items {
+			if !slices.Contains(selected, item) {
+				siblings = append(siblings, item)
+			}
+		}
+		err = setItemsEnabled(ctx.Client, sceneName, siblings, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, item := range selected {
+		if cmd.Group != "" {
+			fmt.Fprintf(
+				ctx.Out,
+				"Scene item %s in group %s is now visible.\n",
+				ctx.Style.Highlight(item.SourceName),
+				ctx.Style.Highlight(cmd.Group),
+			)
+		} else {
+			fmt.Fprintf(
+				ctx.Out,
+				"Scene item %s in scene %s is now visible.\n",
+				ctx.Style.Highlight(item.SourceName),
+				ctx.Style.Highlight(cmd.SceneName),
+			)
+		}
 	}
 
 	return nil
@@ -236,46 +448,48 @@ func (cmd *SceneItemShowCmd) Run(ctx *context) error {
 
 // SceneItemHideCmd provides a command to hide a scene item.
 type SceneItemHideCmd struct {
-	Group string `flag:"" help:"Parent group name."`
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneItemSelectFlags `embed:""`
 
-	SceneName string `arg:"" help:"Scene name."`
-	ItemName  string `arg:"" help:"Item name."`
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name, UUID, glob or regular expression." optional:""`
 }
 
 // Run executes the command to hide a scene item.
 func (cmd *SceneItemHideCmd) Run(ctx *context) error {
-	sceneName, sceneItemID, err := getSceneNameAndItemID(
+	sceneName, selected, _, err := selectSceneItems(
 		ctx,
 		cmd.SceneName,
 		cmd.ItemName,
 		cmd.Group,
+		cmd.SceneItemSelectFlags,
 	)
 	if err != nil {
 		return err
 	}
 
-	_, err = ctx.Client.SceneItems.SetSceneItemEnabled(sceneitems.NewSetSceneItemEnabledParams().
-		WithSceneName(sceneName).
-		WithSceneItemId(sceneItemID).
-		WithSceneItemEnabled(false))
+	err = setItemsEnabled(ctx.Client, sceneName, selected, false)
 	if err != nil {
 		return err
 	}
 
-	if cmd.Group != "" {
-		fmt.Fprintf(
-			ctx.Out,
-			"Scene item %s in group %s is now hidden.\n",
-			ctx.Style.Highlight(cmd.ItemName),
-			ctx.Style.Highlight(cmd.Group),
-		)
-	} else {
-		fmt.Fprintf(
-			ctx.Out,
-			"Scene item %s in scene %s is now hidden.\n",
-			ctx.Style.Highlight(cmd.ItemName),
-			ctx.Style.Highlight(cmd.SceneName),
-		)
+	for _, item := range selected {
+		if cmd.Group != "" {
+			fmt.Fprintf(
+				ctx.Out,
+				"Scene item %s in group %s is now hidden.\n",
+				ctx.Style.Highlight(item.SourceName),
+				ctx.Style.Highlight(cmd.Group),
+			)
+		} else {
+			fmt.Fprintf(
+				ctx.Out,
+				"Scene item %s in scene %s is now hidden.\n",
+				ctx.Style.Highlight(item.SourceName),
+				ctx.Style.Highlight(cmd.SceneName),
+			)
+		}
 	}
 
 	return nil
@@ -294,33 +508,83 @@ func getItemEnabled(client *goobs.Client, sceneName string, itemID int) (bool, e
 
 // SceneItemToggleCmd provides a command to toggle the visibility of a scene item.
 type SceneItemToggleCmd struct {
-	Group string `flag:"" help:"Parent group name."`
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneItemSelectFlags `embed:""`
 
-	SceneName string `arg:"" help:"Scene name."`
-	ItemName  string `arg:"" help:"Item name."`
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name, UUID, glob or regular expression." optional:""`
 }
 
 // Run executes the command to toggle the visibility of a scene item.
 func (cmd *SceneItemToggleCmd) Run(ctx *context) error {
-	sceneName, sceneItemID, err := getSceneNameAndItemID(
+	sceneName, selected, _, err := selectSceneItems(
 		ctx,
 		cmd.SceneName,
 		cmd.ItemName,
 		cmd.Group,
+		cmd.SceneItemSelectFlags,
 	)
 	if err != nil {
 		return err
 	}
 
-	itemEnabled, err := getItemEnabled(ctx.Client, sceneName, sceneItemID)
+	for _, item := range selected {
+		_, err = ctx.Client.SceneItems.SetSceneItemEnabled(sceneitems.NewSetSceneItemEnabledParams().
+			WithSceneName(sceneName).
+			WithSceneItemId(item.SceneItemID).
+			WithSceneItemEnabled(!item.SceneItemEnabled))
+		if err != nil {
+			return err
+		}
+
+		state := "visible"
+		if item.SceneItemEnabled {
+			state = "hidden"
+		}
+		if cmd.Group != "" {
+			fmt.Fprintf(
+				ctx.Out,
+				"Scene item %s in group %s is now %s.\n",
+				ctx.Style.Highlight(item.SourceName),
+				ctx.Style.Highlight(cmd.Group),
+				state,
+			)
+		} else {
+			fmt.Fprintf(
+				ctx.Out,
+				"Scene item %s in scene %s is now %s.\n",
+				ctx.Style.Highlight(item.SourceName),
+				ctx.Style.Highlight(cmd.SceneName),
+				state,
+			)
+		}
+	}
+
+	return nil
+}
+
+// SceneItemVisibleCmd provides a command to check the visibility of a scene item.
+type SceneItemVisibleCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+}
+
+// Run executes the command to check the visibility of a scene item.
+func (cmd *SceneItemVisibleCmd) Run(ctx *context) error {
+	sceneName, sceneItemID, err := getSceneNameAndItemID(
+		ctx,
+		cmd.SceneName,
+		cmd.ItemName,
+		cmd.Group,
+	)
 	if err != nil {
 		return err
 	}
 
-	_, err = ctx.Client.SceneItems.SetSceneItemEnabled(sceneitems.NewSetSceneItemEnabledParams().
-		WithSceneName(sceneName).
-		WithSceneItemId(sceneItemID).
-		WithSceneItemEnabled(!itemEnabled))
+	itemEnabled, err := getItemEnabled(ctx.Client, sceneName, sceneItemID)
 	if err != nil {
 		return err
 	}
@@ -328,32 +592,100 @@ func (cmd *SceneItemToggleCmd) Run(ctx *context) error {
 	if itemEnabled {
 		fmt.Fprintf(
 			ctx.Out,
-			"Scene item %s in scene %s is now hidden.\n",
+			"Scene item %s in scene %s is visible.\n",
 			ctx.Style.Highlight(cmd.ItemName),
 			ctx.Style.Highlight(cmd.SceneName),
 		)
 	} else {
 		fmt.Fprintf(
 			ctx.Out,
-			"Scene item %s in scene %s is now visible.\n",
+			"Scene item %s in scene %s is hidden.\n",
 			ctx.Style.Highlight(cmd.ItemName),
 			ctx.Style.Highlight(cmd.SceneName),
 		)
 	}
-
 	return nil
 }
 
-// SceneItemVisibleCmd provides a command to check the visibility of a scene item.
-type SceneItemVisibleCmd struct {
-	Group string `flag:"" help:"Parent group name."`
+// setItemTransform sets the transform of a scene item.
+func setItemTransform(
+	client *goobs.Client,
+	sceneName string,
+	itemID int,
+	transform *typedefs.SceneItemTransform,
+) error {
+	// OBS rejects bounds smaller than 1px, even when the bounds type is none
+	transform.BoundsWidth = max(transform.BoundsWidth, 1)
+	transform.BoundsHeight = max(transform.BoundsHeight, 1)
 
-	SceneName string `arg:"" help:"Scene name."`
-	ItemName  string `arg:"" help:"Item name."`
+	_, err := client.SceneItems.SetSceneItemTransform(
+		sceneitems.NewSetSceneItemTransformParams().
+			WithSceneName(sceneName).
+			WithSceneItemId(itemID).
+			WithSceneItemTransform(transform),
+	)
+	return err
 }
 
-// Run executes the command to check the visibility of a scene item.
-func (cmd *SceneItemVisibleCmd) Run(ctx *context) error {
+// TransformFlags provides flags for updating a scene item transform.
+// Numeric values may be absolute or relative to the current value (e.g. --position-x=+=50).
+type TransformFlags struct {
+	Alignment       alignmentValue  `flag:"" help:"Alignment of the scene item, by name or OBS bitmask."                                                                                  placeholder:"ALIGNMENT"`
+	BoundsAlignment alignmentValue  `flag:"" help:"Bounds alignment of the scene item, by name or OBS bitmask."                                                                           placeholder:"ALIGNMENT"`
+	BoundsHeight    transformValue  `flag:"" help:"Bounds height of the scene item."                                                                                                      placeholder:"VALUE"`
+	BoundsType      boundsTypeValue `flag:"" help:"Bounds type of the scene item, by name or OBS_BOUNDS_* value."                                                                         placeholder:"TYPE"`
+	BoundsWidth     transformValue  `flag:"" help:"Bounds width of the scene item."                                                                                                       placeholder:"VALUE"`
+	CropToBounds    *bool           `flag:"" help:"Whether to crop the scene item to bounds."                                                                                                                 negatable:""`
+	CropBottom      transformValue  `flag:"" help:"Crop bottom value of the scene item."                                                                                                  placeholder:"VALUE"`
+	CropLeft        transformValue  `flag:"" help:"Crop left value of the scene item."                                                                                                    placeholder:"VALUE"`
+	CropRight       transformValue  `flag:"" help:"Crop right value of the scene item."                                                                                                   placeholder:"VALUE"`
+	CropTop         transformValue  `flag:"" help:"Crop top value of the scene item."                                                                                                     placeholder:"VALUE"`
+	PositionX       transformValue  `flag:"" help:"X position of the scene item."                                                                                                         placeholder:"VALUE"`
+	PositionY       transformValue  `flag:"" help:"Y position of the scene item."                                                                                                         placeholder:"VALUE"`
+	Rotation        transformValue  `flag:"" help:"Rotation of the scene item."                                                                                                           placeholder:"VALUE"`
+	Scale           transformValue  `flag:"" help:"X and Y scale of the scene item."                                                                                                      placeholder:"VALUE"`
+	ScaleX          transformValue  `flag:"" help:"X scale of the scene item."                                                                                                            placeholder:"VALUE"`
+	ScaleY          transformValue  `flag:"" help:"Y scale of the scene item."                                                                                                            placeholder:"VALUE"`
+}
+
+// apply updates transform with the provided flags, leaving fields untouched if their flag was omitted.
+func (f *TransformFlags) apply(transform *typedefs.SceneItemTransform) {
+	f.Alignment.applyTo(&transform.Alignment)
+	f.BoundsAlignment.applyTo(&transform.BoundsAlignment)
+	f.BoundsHeight.applyTo(&transform.BoundsHeight)
+	if f.BoundsType != "" {
+		transform.BoundsType = string(f.BoundsType)
+	}
+	f.BoundsWidth.applyTo(&transform.BoundsWidth)
+
+	if f.CropToBounds != nil {
+		transform.CropToBounds = *f.CropToBounds
+	}
+	f.CropBottom.applyTo(&transform.CropBottom)
+	f.CropLeft.applyTo(&transform.CropLeft)
+	f.CropRight.applyTo(&transform.CropRight)
+	f.CropTop.applyTo(&transform.CropTop)
+	f.PositionX.applyTo(&transform.PositionX)
+	f.PositionY.applyTo(&transform.PositionY)
+	f.Rotation.applyTo(&transform.Rotation)
+	f.Scale.applyTo(&transform.ScaleX)
+	f.Scale.applyTo(&transform.ScaleY)
+	f.ScaleX.applyTo(&transform.ScaleX)
+	f.ScaleY.applyTo(&transform.ScaleY)
+}
+
+// SceneItemTransformCmd provides a command to transform a scene item.
+type SceneItemTransformCmd struct {
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	TransformFlags `embed:""`
+}
+
+// Run executes the command to transform a scene item.
+func (cmd *SceneItemTransformCmd) Run(ctx *context) error {
 	sceneName, sceneItemID, err := getSceneNameAndItemID(
 		ctx,
 		cmd.SceneName,
@@ -364,55 +696,70 @@ func (cmd *SceneItemVisibleCmd) Run(ctx *context) error {
 		return err
 	}
 
-	itemEnabled, err := getItemEnabled(ctx.Client, sceneName, sceneItemID)
+	// Get the current transform of the scene item
+	resp, err := ctx.Client.SceneItems.GetSceneItemTransform(
+		sceneitems.NewGetSceneItemTransformParams().
+			WithSceneName(sceneName).
+			WithSceneItemId(sceneItemID),
+	)
 	if err != nil {
 		return err
 	}
 
-	if itemEnabled {
+	// Update the transform with the provided values
+	transform := resp.SceneItemTransform
+
+	cmd.apply(transform)
+
+	err = setItemTransform(ctx.Client, sceneName, sceneItemID, transform)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Group != "" {
 		fmt.Fprintf(
 			ctx.Out,
-			"Scene item %s in scene %s is visible.\n",
+			"Scene item %s in group %s transformed.\n",
 			ctx.Style.Highlight(cmd.ItemName),
-			ctx.Style.Highlight(cmd.SceneName),
+			ctx.Style.Highlight(cmd.Group),
 		)
 	} else {
 		fmt.Fprintf(
 			ctx.Out,
-			"Scene item %s in scene %s is hidden.\n",
+			"Scene item %s in scene %s transformed.\n",
 			ctx.Style.Highlight(cmd.ItemName),
 			ctx.Style.Highlight(cmd.SceneName),
 		)
 	}
+
 	return nil
 }
 
-// SceneItemTransformCmd provides a command to transform a scene item.
-type SceneItemTransformCmd struct {
-	SceneName string `arg:"" help:"Scene name."`
-	ItemName  string `arg:"" help:"Item name."`
-
-	Group string `flag:"" help:"Parent group name."`
-
-	Alignment       float64 `flag:"" help:"Alignment of the scene item."`
-	BoundsAlignment float64 `flag:"" help:"Bounds alignment of the scene item."`
-	BoundsHeight    float64 `flag:"" help:"Bounds height of the scene item."          default:"1.0"`
-	BoundsType      string  `flag:"" help:"Bounds type of the scene item."            default:"OBS_BOUNDS_NONE"`
-	BoundsWidth     float64 `flag:"" help:"Bounds width of the scene item."           default:"1.0"`
-	CropToBounds    bool    `flag:"" help:"Whether to crop the scene item to bounds."`
-	CropBottom      float64 `flag:"" help:"Crop bottom value of the scene item."`
-	CropLeft        float64 `flag:"" help:"Crop left value of the scene item."`
-	CropRight       float64 `flag:"" help:"Crop right value of the scene item."`
-	CropTop         float64 `flag:"" help:"Crop top value of the scene item."`
-	PositionX       float64 `flag:"" help:"X position of the scene item."`
-	PositionY       float64 `flag:"" help:"Y position of the scene item."`
-	Rotation        float64 `flag:"" help:"Rotation of the scene item."`
-	ScaleX          float64 `flag:"" help:"X scale of the scene item."`
-	ScaleY          float64 `flag:"" help:"Y scale of the scene item."`
+// SceneItemAnimateCmd provides a command to animate a scene item from its current transform to a target transform.
+// Target values may be absolute or relative to the current value (e.g. --to-x=+=200).
+type SceneItemAnimateCmd struct {
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	ToX        transformValue `flag:"" help:"Target X position of the scene item."    placeholder:"VALUE"`
+	ToY        transformValue `flag:"" help:"Target Y position of the scene item."    placeholder:"VALUE"`
+	ToScale    transformValue `flag:"" help:"Target X and Y scale of the scene item." placeholder:"VALUE"`
+	ToScaleX   transformValue `flag:"" help:"Target X scale of the scene item."       placeholder:"VALUE"`
+	ToScaleY   transformValue `flag:"" help:"Target Y scale of the scene item."       placeholder:"VALUE"`
+	ToRotation transformValue `flag:"" help:"Target rotation of the scene item."      placeholder:"VALUE"`
+
+	Duration time.Duration `flag:"" help:"Duration of the animation."        default:"1s"`
+	Easing   string        `flag:"" help:"Easing function of the animation." default:"ease-in-out" enum:"linear,ease-in,ease-out,ease-in-out"`
 }
 
-// Run executes the command to transform a scene item.
-func (cmd *SceneItemTransformCmd) Run(ctx *context) error {
+// Run executes the command to animate a scene item.
+func (cmd *SceneItemAnimateCmd) Run(ctx *context) error {
+	if cmd.Duration < 0 {
+		return fmt.Errorf("duration must not be negative")
+	}
+
 	sceneName, sceneItemID, err := getSceneNameAndItemID(
 		ctx,
 		cmd.SceneName,
@@ -423,7 +770,6 @@ func (cmd *SceneItemTransformCmd) Run(ctx *context) error {
 		return err
 	}
 
-	// Get the current transform of the scene item
 	resp, err := ctx.Client.SceneItems.GetSceneItemTransform(
 		sceneitems.NewGetSceneItemTransformParams().
 			WithSceneName(sceneName).
@@ -432,79 +778,359 @@ func (cmd *SceneItemTransformCmd) Run(ctx *context) error {
 	if err != nil {
 		return err
 	}
+	from := *resp.SceneItemTransform
 
-	// Update the transform with the provided values
-	transform := resp.SceneItemTransform
+	to := from
+	cmd.ToX.applyTo(&to.PositionX)
+	cmd.ToY.applyTo(&to.PositionY)
+	cmd.ToScale.applyTo(&to.ScaleX)
+	cmd.ToScale.applyTo(&to.ScaleY)
+	cmd.ToScaleX.applyTo(&to.ScaleX)
+	cmd.ToScaleY.applyTo(&to.ScaleY)
+	cmd.ToRotation.applyTo(&to.Rotation)
 
-	if cmd.Alignment != 0 {
-		transform.Alignment = cmd.Alignment
+	// Send one transform per frame of the canvas
+	video, err := ctx.Client.Config.GetVideoSettings()
+	if err != nil {
+		return fmt.Errorf("failed to get video settings: %w", err)
 	}
-	if cmd.BoundsAlignment != 0 {
-		transform.BoundsAlignment = cmd.BoundsAlignment
+	interval := frameInterval(video.FpsNumerator, video.FpsDenominator)
+
+	err = animate(cmd.Duration, interval, cmd.Easing, func(t float64) error {
+		transform := from
+		transform.PositionX = lerp(from.PositionX, to.PositionX, t)
+		transform.PositionY = lerp(from.PositionY, to.PositionY, t)
+		transform.ScaleX = lerp(from.ScaleX, to.ScaleX, t)
+		transform.ScaleY = lerp(from.ScaleY, to.ScaleY, t)
+		transform.Rotation = lerp(from.Rotation, to.Rotation, t)
+		return setItemTransform(ctx.Client, sceneName, sceneItemID, &transform)
+	})
+	if err != nil {
+		return err
 	}
 
-	if cmd.BoundsHeight != 0 {
-		transform.BoundsHeight = cmd.BoundsHeight
+	if cmd.Group != "" {
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in group %s animated.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.Group),
+		)
+	} else {
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in scene %s animated.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.SceneName),
+		)
+	}
+
+	return nil
+}
+
+// SceneItemLayoutCmd provides a command to arrange scene items in a grid, side-by-side or picture-in-picture layout.
+type SceneItemLayoutCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	Grid       string  `flag:"" help:"Arrange items in a grid of COLSxROWS (e.g. 2x2)."                                                 xor:"layout" placeholder:"COLSxROWS"`
+	SideBySide bool    `flag:"" help:"Arrange items side by side."                                                                      xor:"layout"`
+	Pip        bool    `flag:"" help:"Arrange the first item full screen and the second as picture-in-picture, raised above the first." xor:"layout"`
+	PipCorner  string  `flag:"" help:"Corner of the picture-in-picture item."                                                                        default:"bottom-right" enum:"top-left,top-right,bottom-left,bottom-right"`
+	PipSize    float64 `flag:"" help:"Size of the picture-in-picture item as a fraction of the canvas."                                              default:"0.3"`
+	Margin     float64 `flag:"" help:"Margin in pixels between items and the canvas edges."                                                          default:"0"`
+
+	SceneName string   `arg:"" help:"Scene name."`
+	ItemNames []string `arg:"" help:"Item names, in layout order."`
+}
+
+// Run executes the command to arrange scene items in a layout.
+func (cmd *SceneItemLayoutCmd) Run(ctx *context) error {
+	video, err := ctx.Client.Config.GetVideoSettings()
+	if err != nil {
+		return fmt.Errorf("failed to get video settings: %w", err)
 	}
-	if cmd.BoundsType != "" {
-		transform.BoundsType = cmd.BoundsType
+
+	var cells []rect
+	switch {
+	case cmd.Grid != "":
+		cols, rows, ok := parseGrid(cmd.Grid)
+		if !ok {
+			return fmt.Errorf("invalid grid %s, expected COLSxROWS (e.g. 2x2)", ctx.Style.Error(cmd.Grid))
+		}
+		cells, err = gridCells(cols, rows, video.BaseWidth, video.BaseHeight, cmd.Margin)
+	case cmd.SideBySide:
+		cells, err = gridCells(len(cmd.ItemNames), 1, video.BaseWidth, video.BaseHeight, cmd.Margin)
+	case cmd.Pip:
+		if len(cmd.ItemNames) != 2 {
+			return fmt.Errorf("picture-in-picture requires exactly 2 items")
+		}
+		if cmd.PipSize <= 0 || cmd.PipSize >= 1 {
+			return fmt.Errorf("pip size must be between 0 and 1")
+		}
+		cells, err = pipCells(cmd.PipCorner, cmd.PipSize, video.BaseWidth, video.BaseHeight, cmd.Margin)
+	default:
+		return fmt.Errorf("one of %s, %s or %s is required",
+			ctx.Style.Error("--grid"), ctx.Style.Error("--side-by-side"), ctx.Style.Error("--pip"))
 	}
-	if cmd.BoundsWidth != 0 {
-		transform.BoundsWidth = cmd.BoundsWidth
+	if err != nil {
+		return err
 	}
 
-	if cmd.CropToBounds {
-		transform.CropToBounds = cmd.CropToBounds
+	if len(cmd.ItemNames) > len(cells) {
+		return fmt.Errorf("layout has room for %d items, got %d", len(cells), len(cmd.ItemNames))
 	}
-	if cmd.CropBottom != 0 {
-		transform.CropBottom = cmd.CropBottom
+
+	for i, itemName := range cmd.ItemNames {
+		sceneName, sceneItemID, err := getSceneNameAndItemID(
+			ctx,
+			cmd.SceneName,
+			itemName,
+			cmd.Group,
+		)
+		if err != nil {
+			return err
+		}
+
+		resp, err := ctx.Client.SceneItems.GetSceneItemTransform(
+			sceneitems.NewGetSceneItemTransformParams().
+				WithSceneName(sceneName).
+				WithSceneItemId(sceneItemID),
+		)
+		if err != nil {
+			return err
+		}
+
+		transform := resp.SceneItemTransform
+		fitToCell(transform, cells[i])
+
+		err = setItemTransform(ctx.Client, sceneName, sceneItemID, transform)
+		if err != nil {
+			return err
+		}
+
+		// Raise the picture-in-picture item so it is drawn over the full screen item
+		if cmd.Pip && i == 1 {
+			if err := raiseSceneItem(ctx, sceneName, sceneItemID, cmd.Group != ""); err != nil {
+				return err
+			}
+		}
 	}
-	if cmd.CropLeft != 0 {
-		transform.CropLeft = cmd.CropLeft
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Arranged %d items in scene %s.\n",
+		len(cmd.ItemNames),
+		ctx.Style.Highlight(cmd.SceneName),
+	)
+	return nil
+}
+
+// raiseSceneItem moves a scene item to the top of its scene or group.
+func raiseSceneItem(ctx *context, container string, sceneItemID int, isGroup bool) error {
+	var count int
+	if isGroup {
+		resp, err := ctx.Client.SceneItems.GetGroupSceneItemList(
+			sceneitems.NewGetGroupSceneItemListParams().
+				WithSceneName(container),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get group scene item list: %w", err)
+		}
+		count = len(resp.SceneItems)
+	} else {
+		resp, err := ctx.Client.SceneItems.GetSceneItemList(
+			sceneitems.NewGetSceneItemListParams().
+				WithSceneName(container),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get scene item list: %w", err)
+		}
+		count = len(resp.SceneItems)
 	}
-	if cmd.CropRight != 0 {
-		transform.CropRight = cmd.CropRight
+
+	_, err := ctx.Client.SceneItems.SetSceneItemIndex(sceneitems.NewSetSceneItemIndexParams().
+		WithSceneName(container).
+		WithSceneItemId(sceneItemID).
+		WithSceneItemIndex(count - 1))
+	if err != nil {
+		return fmt.Errorf("failed to set scene item index: %w", err)
 	}
-	if cmd.CropTop != 0 {
-		transform.CropTop = cmd.CropTop
+	return nil
+}
+
+// SceneItemInfoCmd provides a command to show the current transform of a scene item.
+type SceneItemInfoCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+	JSON  bool   `flag:"" help:"Output the transform as JSON."`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+}
+
+// Run executes the command to show the current transform of a scene item.
+// nolint: misspell
+func (cmd *SceneItemInfoCmd) Run(ctx *context) error {
+	sceneName, sceneItemID, err := getSceneNameAndItemID(
+		ctx,
+		cmd.SceneName,
+		cmd.ItemName,
+		cmd.Group,
+	)
+	if err != nil {
+		return err
 	}
-	if cmd.PositionX != 0 {
-		transform.PositionX = cmd.PositionX
+
+	resp, err := ctx.Client.SceneItems.GetSceneItemTransform(
+		sceneitems.NewGetSceneItemTransformParams().
+			WithSceneName(sceneName).
+			WithSceneItemId(sceneItemID),
+	)
+	if err != nil {
+		return err
 	}
-	if cmd.PositionY != 0 {
-		transform.PositionY = cmd.PositionY
+	transform := resp.SceneItemTransform
+
+	if cmd.JSON {
+		data, err := json.MarshalIndent(transform, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal scene item transform: %w", err)
+		}
+		fmt.Fprintln(ctx.Out, string(data))
+		return nil
 	}
-	if cmd.Rotation != 0 {
-		transform.Rotation = cmd.Rotation
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border)).
+		Headers("Transform", "Value").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 3)
+			switch col {
+			case 0:
+				style = style.Align(lipgloss.Left)
+			case 1:
+				style = style.Align(lipgloss.Right)
+			}
+			switch {
+			case row == table.HeaderRow:
+				style = style.Bold(true).Align(lipgloss.Center)
+			case row%2 == 0:
+				style = style.Foreground(ctx.Style.evenRows)
+			default:
+				style = style.Foreground(ctx.Style.oddRows)
+			}
+			return style
+		})
+
+	t.Row("Position X", fmt.Sprintf("%.2f", transform.PositionX))
+	t.Row("Position Y", fmt.Sprintf("%.2f", transform.PositionY))
+	t.Row("Scale X", fmt.Sprintf("%.3f", transform.ScaleX))
+	t.Row("Scale Y", fmt.Sprintf("%.3f", transform.ScaleY))
+	t.Row("Rotation", fmt.Sprintf("%.2f", transform.Rotation))
+	t.Row("Width", fmt.Sprintf("%.0f", transform.Width))
+	t.Row("Height", fmt.Sprintf("%.0f", transform.Height))
+	t.Row("Source Width", fmt.Sprintf("%.0f", transform.SourceWidth))
+	t.Row("Source Height", fmt.Sprintf("%.0f", transform.SourceHeight))
+	t.Row("Alignment", alignmentName(transform.Alignment))
+	t.Row("Crop Left", fmt.Sprintf("%.0f", transform.CropLeft))
+	t.Row("Crop Right", fmt.Sprintf("%.0f", transform.CropRight))
+	t.Row("Crop Top", fmt.Sprintf("%.0f", transform.CropTop))
+	t.Row("Crop Bottom", fmt.Sprintf("%.0f", transform.CropBottom))
+	t.Row("Crop To Bounds", getEnabledMark(transform.CropToBounds))
+	t.Row("Bounds Type", boundsTypeName(transform.BoundsType))
+	t.Row("Bounds Width", fmt.Sprintf("%.0f", transform.BoundsWidth))
+	t.Row("Bounds Height", fmt.Sprintf("%.0f", transform.BoundsHeight))
+	t.Row("Bounds Alignment", alignmentName(transform.BoundsAlignment))
+
+	fmt.Fprintln(ctx.Out, t.Render())
+	return nil
+}
+
+// SceneItemLockCmd provides a command to lock a scene item.
+type SceneItemLockCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+}
+
+// Run executes the command to lock a scene item.
+func (cmd *SceneItemLockCmd) Run(ctx *context) error {
+	sceneName, sceneItemID, err := getSceneNameAndItemID(
+		ctx,
+		cmd.SceneName,
+		cmd.ItemName,
+		cmd.Group,
+	)
+	if err != nil {
+		return err
 	}
-	if cmd.ScaleX != 0 {
-		transform.ScaleX = cmd.ScaleX
+
+	_, err = ctx.Client.SceneItems.SetSceneItemLocked(sceneitems.NewSetSceneItemLockedParams().
+		WithSceneName(sceneName).
+		WithSceneItemId(sceneItemID).
+		WithSceneItemLocked(true))
+	if err != nil {
+		return err
 	}
-	if cmd.ScaleY != 0 {
-		transform.ScaleY = cmd.ScaleY
+
+	if cmd.Group != "" {
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in group %s is now locked.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.Group),
+		)
+	} else {
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in scene %s is now locked.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.SceneName),
+		)
 	}
 
-	_, err = ctx.Client.SceneItems.SetSceneItemTransform(
-		sceneitems.NewSetSceneItemTransformParams().
-			WithSceneName(sceneName).
-			WithSceneItemId(sceneItemID).
-			WithSceneItemTransform(transform),
+	return nil
+}
+
+// SceneItemUnlockCmd provides a command to unlock a scene item.
+type SceneItemUnlockCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+}
+
+// Run executes the command to unlock a scene item.
+func (cmd *SceneItemUnlockCmd) Run(ctx *context) error {
+	sceneName, sceneItemID, err := getSceneNameAndItemID(
+		ctx,
+		cmd.SceneName,
+		cmd.ItemName,
+		cmd.Group,
 	)
 	if err != nil {
 		return err
 	}
 
+	_, err = ctx.Client.SceneItems.SetSceneItemLocked(sceneitems.NewSetSceneItemLockedParams().
+		WithSceneName(sceneName).
+		WithSceneItemId(sceneItemID).
+		WithSceneItemLocked(false))
+	if err != nil {
+		return err
+	}
+
 	if cmd.Group != "" {
 		fmt.Fprintf(
 			ctx.Out,
-			"Scene item %s in group %s transformed.\n",
+			"Scene item %s in group %s is now unlocked.\n",
 			ctx.Style.Highlight(cmd.ItemName),
 			ctx.Style.Highlight(cmd.Group),
 		)
 	} else {
 		fmt.Fprintf(
 			ctx.Out,
-			"Scene item %s in scene %s transformed.\n",
+			"Scene item %s in scene %s is now unlocked.\n",
 			ctx.Style.Highlight(cmd.ItemName),
 			ctx.Style.Highlight(cmd.SceneName),
 		)
@@ -512,3 +1138,118 @@ func (cmd *SceneItemTransformCmd) Run(ctx *context) error {
 
 	return nil
 }
+
+// SceneItemLockedCmd provides a command to check the lock state of a scene item.
+type SceneItemLockedCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+}
+
+// Run executes the command to check the lock state of a scene item.
+func (cmd *SceneItemLockedCmd) Run(ctx *context) error {
+	sceneName, sceneItemID, err := getSceneNameAndItemID(
+		ctx,
+		cmd.SceneName,
+		cmd.ItemName,
+		cmd.Group,
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ctx.Client.SceneItems.GetSceneItemLocked(sceneitems.NewGetSceneItemLockedParams().
+		WithSceneName(sceneName).
+		WithSceneItemId(sceneItemID))
+	if err != nil {
+		return err
+	}
+
+	state := "unlocked"
+	if resp.SceneItemLocked {
+		state = "locked"
+	}
+	if cmd.Group != "" {
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in group %s is %s.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.Group),
+			state,
+		)
+	} else {
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in scene %s is %s.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.SceneName),
+			state,
+		)
+	}
+	return nil
+}
+
+// blendModePrefix is the prefix OBS uses for its blend mode identifiers.
+const blendModePrefix = "OBS_BLEND_"
+
+// SceneItemBlendCmd provides a command to get or set the blend mode of a scene item.
+type SceneItemBlendCmd struct {
+	Group string `flag:"" help:"Parent group name or UUID."`
+
+	SceneName string `arg:"" help:"Scene name or UUID."`
+	ItemName  string `arg:"" help:"Item name or UUID."`
+	Mode      string `arg:"" help:"Blend mode to set. If not provided, the current blend mode will be displayed." optional:"" enum:",normal,additive,subtract,screen,multiply,lighten,darken" default:""`
+}
+
+// Run executes the command to get or set the blend mode of a scene item.
+func (cmd *SceneItemBlendCmd) Run(ctx *context) error {
+	sceneName, sceneItemID, err := getSceneNameAndItemID(
+		ctx,
+		cmd.SceneName,
+		cmd.ItemName,
+		cmd.Group,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Mode == "" {
+		resp, err := ctx.Client.SceneItems.GetSceneItemBlendMode(
+			sceneitems.NewGetSceneItemBlendModeParams().
+				WithSceneName(sceneName).
+				WithSceneItemId(sceneItemID),
+		)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(
+			ctx.Out,
+			"Scene item %s in scene %s has blend mode %s.\n",
+			ctx.Style.Highlight(cmd.ItemName),
+			ctx.Style.Highlight(cmd.SceneName),
+			ctx.Style.Highlight(strings.ToLower(trimPrefix(resp.SceneItemBlendMode, blendModePrefix))),
+		)
+		return nil
+	}
+
+	_, err = ctx.Client.SceneItems.SetSceneItemBlendMode(
+		sceneitems.NewSetSceneItemBlendModeParams().
+			WithSceneName(sceneName).
+			WithSceneItemId(sceneItemID).
+			WithSceneItemBlendMode(blendModePrefix + strings.ToUpper(cmd.Mode)),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Scene item %s in scene %s blend mode set to %s.\n",
+		ctx.Style.Highlight(cmd.ItemName),
+		ctx.Style.Highlight(cmd.SceneName),
+		ctx.Style.Highlight(cmd.Mode),
+	)
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+
+	"github.com/andreykaipov/goobs/api/requests/sceneitems"
 )
 
 func TestSceneItemList(t *testing.T) {
@@ -27,3 +29,163 @@ func TestSceneItemList(t *testing.T) {
 		t.Fatalf("Expected output to contain 'gobs-test-input-2', got '%s'", out.String())
 	}
 }
+
+func TestSceneItemLock(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdLock := &SceneItemLockCmd{
+		SceneName: "gobs-test-scene",
+		ItemName:  "gobs-test-input",
+	}
+	err := cmdLock.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to lock scene item: %v", err)
+	}
+	if out.String() != "Scene item gobs-test-input in scene gobs-test-scene is now locked.\n" {
+		t.Fatalf(
+			"Expected output to be 'Scene item gobs-test-input in scene gobs-test-scene is now locked.', got '%s'",
+			out.String(),
+		)
+	}
+	// Reset output buffer for the next command
+	out.Reset()
+
+	cmdLocked := &SceneItemLockedCmd{
+		SceneName: "gobs-test-scene",
+		ItemName:  "gobs-test-input",
+	}
+	err = cmdLocked.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get scene item lock state: %v", err)
+	}
+	if out.String() != "Scene item gobs-test-input in scene gobs-test-scene is locked.\n" {
+		t.Fatalf(
+			"Expected output to be 'Scene item gobs-test-input in scene gobs-test-scene is locked.', got '%s'",
+			out.String(),
+		)
+	}
+	// Reset output buffer for the next command
+	out.Reset()
+
+	cmdUnlock := &SceneItemUnlockCmd{
+		SceneName: "gobs-test-scene",
+		ItemName:  "gobs-test-input",
+	}
+	err = cmdUnlock.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to unlock scene item: %v", err)
+	}
+}
+
+func TestSceneItemBlend(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmdSet := &SceneItemBlendCmd{
+		SceneName: "gobs-test-scene",
+		ItemName:  "gobs-test-input",
+		Mode:      "multiply",
+	}
+	err := cmdSet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to set scene item blend mode: %v", err)
+	}
+	// Reset output buffer for the next command
+	out.Reset()
+
+	cmdGet := &SceneItemBlendCmd{
+		SceneName: "gobs-test-scene",
+		ItemName:  "gobs-test-input",
+	}
+	err = cmdGet.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get scene item blend mode: %v", err)
+	}
+	if !strings.Contains(out.String(), "multiply") {
+		t.Fatalf("Expected output to contain 'multiply', got '%s'", out.String())
+	}
+}
+
+func TestSceneItemInfo(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	cmd := &SceneItemInfoCmd{
+		SceneName: "gobs-test-scene",
+		ItemName:  "gobs-test-input",
+		JSON:      true,
+	}
+	err := cmd.Run(context)
+	if err != nil {
+		t.Fatalf("Failed to get scene item transform: %v", err)
+	}
+	if !strings.Contains(out.String(), `"sourceWidth": 1920`) {
+		t.Fatalf("Expected output to contain '\"sourceWidth\": 1920', got '%s'", out.String())
+	}
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	tests := []struct {
+		glob     string
+		name     string
+		expected bool
+	}{
+		{"lower-third-*", "lower-third-guest", true},
+		{"lower-third-*", "camera-1", false},
+		{"camera-?", "camera-1", true},
+		{"camera-?", "camera-10", false},
+		{"Mic/*", "Mic/Aux", true},
+		{"Colour Source (1)", "Colour Source (1)", true},
+	}
+
+	for _, test := range tests {
+		re, err := compileSelector(test.glob, false)
+		if err != nil {
+			t.Fatalf("Failed to compile glob '%s': %v", test.glob, err)
+		}
+		if re.MatchString(test.name) != test.expected {
+			t.Errorf("Expected glob '%s' matching '%s' to be %v", test.glob, test.name, test.expected)
+		}
+	}
+}
+
+func TestSceneItemResolveGroupUUID(t *testing.T) {
+	client, disconnect := getClient(t)
+	defer disconnect()
+
+	var out bytes.Buffer
+	context := newContext(client, &out, StyleConfig{})
+
+	resp, err := client.SceneItems.GetSceneItemList(sceneitems.NewGetSceneItemListParams().
+		WithSceneName("Scene"))
+	if err != nil {
+		t.Fatalf("Failed to list scene items: %v", err)
+	}
+	var groupUUID string
+	for _, item := range resp.SceneItems {
+		if item.IsGroup && item.SourceName == "test_group" {
+			groupUUID = item.SourceUuid
+		}
+	}
+	if groupUUID == "" {
+		t.Fatalf("Expected scene 'Scene' to contain group 'test_group'")
+	}
+
+	group, err := resolveGroupName(context, "Scene", groupUUID)
+	if err != nil {
+		t.Fatalf("Failed to resolve group UUID: %v", err)
+	}
+	if group != "test_group" {
+		t.Fatalf("Expected group to resolve to 'test_group', got '%s'", group)
+	}
+}
@@ -1,82 +1,224 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
+	"text/template"
+	"time"
 
+	"github.com/alecthomas/kong"
 	"github.com/andreykaipov/goobs/api/requests/inputs"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
 )
 
 // TextCmd provides commands for managing text inputs in OBS.
 type TextCmd struct {
-	Current TextCurrentCmd `cmd:"current" help:"Display current text for a text input." aliases:"c"`
-	Update  TextUpdateCmd  `cmd:"update"  help:"Update the text of a text input."       aliases:"u"`
+	Current TextCurrentCmd `cmd:"current" help:"Display current text for a text input."              aliases:"c"`
+	Update  TextUpdateCmd  `cmd:"update"  help:"Update the text of a text input."                    aliases:"u"`
+	Timer   TextTimerCmd   `cmd:"timer"   help:"Show a countdown or count-up timer in a text input." aliases:"tm"`
+	Clock   TextClockCmd   `cmd:"clock"   help:"Show the current time in a text input."              aliases:"cl"`
 }
 
 // TextCurrentCmd provides a command to display the current text of a text input.
 type TextCurrentCmd struct {
 	InputName string `arg:"" help:"Name of the text source."`
+
+	Verbose bool `flag:"" help:"Also display the font, colour, outline and file settings."`
 }
 
 // Run executes the command to display the current text of a text input.
 func (cmd *TextCurrentCmd) Run(ctx *context) error {
-	resp, err := ctx.Client.Inputs.GetInputSettings(
-		inputs.NewGetInputSettingsParams().WithInputName(cmd.InputName),
-	)
+	resp, err := getTextInputSettings(ctx, cmd.InputName)
 	if err != nil {
-		return fmt.Errorf("failed to get input settings: %w", err)
+		return err
 	}
+	keys, known := lookupTextKind(resp.InputKind)
 
-	// Check if the input is a text input
-	kind := resp.InputKind
-	if !strings.HasPrefix(kind, "text_") {
-		return fmt.Errorf("input %s is of %s", cmd.InputName, kind)
+	if fromFile, _ := resp.InputSettings[keys.fromFile].(bool); known && fromFile {
+		fmt.Fprintf(
+			ctx.Out,
+			"Text for source %s is read from file: %s\n",
+			ctx.Style.Highlight(cmd.InputName),
+			formatSettingValue(resp.InputSettings[keys.file]),
+		)
+	} else {
+		currentText, ok := resp.InputSettings["text"]
+		if !ok {
+			return fmt.Errorf("input %s does not have a 'text' setting", cmd.InputName)
+		}
+		if currentText == "" {
+			currentText = "(empty)"
+		}
+		fmt.Fprintf(
+			ctx.Out,
+			"Current text for source %s: %s\n",
+			ctx.Style.Highlight(cmd.InputName),
+			currentText,
+		)
 	}
 
-	currentText, ok := resp.InputSettings["text"]
-	if !ok {
-		return fmt.Errorf("input %s does not have a 'text' setting", cmd.InputName)
+	if !cmd.Verbose {
+		return nil
 	}
-	if currentText == "" {
-		currentText = "(empty)"
+	if !known {
+		return fmt.Errorf("styling is not supported for text sources of kind %s", ctx.Style.Error(resp.InputKind))
 	}
-	fmt.Fprintf(
-		ctx.Out,
-		"Current text for source %s: %s\n",
-		ctx.Style.Highlight(cmd.InputName),
-		currentText,
-	)
+	return cmd.printStyle(ctx, resp.InputKind, keys, resp.InputSettings)
+}
+
+// printStyle prints the font, colour, outline and file settings of a text input,
+// falling back to the defaults of its kind for settings which are not set.
+// nolint: misspell
+func (cmd *TextCurrentCmd) printStyle(
+	ctx *context,
+	kind string,
+	keys textKindSettings,
+	current map[string]any,
+) error {
+	defaults, err := textDefaultSettings(ctx, kind)
+	if err != nil {
+		return err
+	}
+	settings := make(map[string]any)
+	maps.Copy(settings, defaults)
+	maps.Copy(settings, current)
+	font := textFont(defaults, current)
+
+	t := table.New().Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(ctx.Style.border)).
+		Headers("Setting", "Value").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 3)
+			switch col {
+			case 0:
+				style = style.Align(lipgloss.Left)
+			case 1:
+				style = style.Align(lipgloss.Left)
+			}
+			switch {
+			case row == table.HeaderRow:
+				style = style.Bold(true).Align(lipgloss.Center)
+			case row%2 == 0:
+				style = style.Foreground(ctx.Style.evenRows)
+			default:
+				style = style.Foreground(ctx.Style.oddRows)
+			}
+			return style
+		})
+
+	t.Row("Kind", kind)
+	t.Row("Font Face", formatSettingValue(font["face"]))
+	t.Row("Font Size", formatSettingValue(font["size"]))
+	for i, key := range keys.colours {
+		name := "Colour"
+		if len(keys.colours) > 1 {
+			name = fmt.Sprintf("Colour %d", i+1)
+		}
+		t.Row(name, formatColour(settings[key]))
+	}
+	outline, _ := settings[keys.outline].(bool)
+	t.Row("Outline", getEnabledMark(outline))
+	if keys.outlineSize != "" {
+		t.Row("Outline Size", formatSettingValue(settings[keys.outlineSize]))
+	}
+	if keys.outlineColour != "" {
+		t.Row("Outline Colour", formatColour(settings[keys.outlineColour]))
+	}
+	fromFile, _ := settings[keys.fromFile].(bool)
+	t.Row("Read From File", getEnabledMark(fromFile))
+	if file, _ := settings[keys.file].(string); file != "" {
+		t.Row("File", file)
+	}
+
+	fmt.Fprintln(ctx.Out, t.Render())
 	return nil
 }
 
+// textWatchInterval is how often a file passed to text update --watch is checked for changes.
+const textWatchInterval = 500 * time.Millisecond
+
 // TextUpdateCmd provides a command to update the text of a text input.
+// The text may be given as an argument, rendered from a template or read from a file.
 type TextUpdateCmd struct {
 	InputName string `arg:"" help:"Name of the text source."`
 	NewText   string `arg:"" help:"New text to set for the source." default:""`
+
+	Template string            `flag:"" help:"Template for the text, variables are set with --var (e.g. 'Next: {{.title}}')." xor:"source"`
+	Var      map[string]string `flag:"" help:"Template variable as key=value, may be repeated."                                            mapsep:"none" placeholder:"KEY=VALUE"`
+	FromFile string            `flag:"" help:"Read the text from a file."                                                     xor:"source"               placeholder:"FILE"`
+	Watch    bool              `flag:"" help:"Keep running and update the text whenever the file changes."`
+
+	TextStyleFlags `embed:""`
 }
 
 // Run executes the command to update the text of a text input.
 func (cmd *TextUpdateCmd) Run(ctx *context) error {
-	resp, err := ctx.Client.Inputs.GetInputSettings(
-		inputs.NewGetInputSettingsParams().WithInputName(cmd.InputName),
-	)
+	if cmd.NewText != "" && (cmd.Template != "" || cmd.FromFile != "") {
+		return errors.New("new text cannot be combined with --template or --from-file")
+	}
+	if len(cmd.Var) > 0 && cmd.Template == "" {
+		return errors.New("--var requires --template")
+	}
+	if cmd.Watch && cmd.FromFile == "" {
+		return errors.New("--watch requires --from-file")
+	}
+
+	resp, err := getTextInputSettings(ctx, cmd.InputName)
 	if err != nil {
-		return fmt.Errorf("failed to get input settings: %w", err)
+		return err
 	}
+	settings, err := cmd.settings(ctx, resp.InputKind, resp.InputSettings)
+	if err != nil {
+		return err
+	}
+	styled := len(settings) > 0
+	merged := maps.Clone(resp.InputSettings)
+	maps.Copy(merged, settings)
 
-	// Check if the input is a text input
-	kind := resp.InputKind
-	if !strings.HasPrefix(kind, "text_") {
-		return fmt.Errorf("input %s is of %s", cmd.InputName, kind)
+	switch {
+	case cmd.Template != "":
+		cmd.NewText, err = renderTextTemplate(cmd.Template, cmd.Var)
+		if err != nil {
+			return err
+		}
+	case cmd.Watch:
+		warnReadsFromFile(ctx, cmd.InputName, resp.InputKind, merged)
+		if styled {
+			if err := setTextInputSettings(ctx, cmd.InputName, settings); err != nil {
+				return err
+			}
+		}
+		return cmd.watch(ctx)
+	case cmd.FromFile != "":
+		cmd.NewText, err = readTextFile(cmd.FromFile)
+		if err != nil {
+			return err
+		}
 	}
 
-	if _, err := ctx.Client.Inputs.SetInputSettings(&inputs.SetInputSettingsParams{
-		InputName:     &cmd.InputName,
-		InputSettings: map[string]any{"text": &cmd.NewText},
-	}); err != nil {
-		return fmt.Errorf("failed to update text for source %s: %w", cmd.InputName, err)
+	// Styling alone leaves the text as it is
+	textGiven := cmd.NewText != "" || cmd.Template != "" || cmd.FromFile != ""
+	if textGiven {
+		warnReadsFromFile(ctx, cmd.InputName, resp.InputKind, merged)
+	}
+	if textGiven || !styled {
+		settings["text"] = cmd.NewText
+	}
+	if err := setTextInputSettings(ctx, cmd.InputName, settings); err != nil {
+		return err
 	}
 
+	if !textGiven && styled {
+		fmt.Fprintf(ctx.Out, "Updated style of text source %s.\n", ctx.Style.Highlight(cmd.InputName))
+		return nil
+	}
 	if cmd.NewText == "" {
 		cmd.NewText = "(empty)"
 	}
@@ -88,3 +230,509 @@ func (cmd *TextUpdateCmd) Run(ctx *context) error {
 	)
 	return nil
 }
+
+// watch updates the text of the input from the file until interrupted, polling the file
+// for a change of modification time or size every textWatchInterval.
+func (cmd *TextUpdateCmd) watch(ctx *context) error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Watching %s for changes to the text of source %s. Press Ctrl+C to stop.\n",
+		cmd.FromFile,
+		ctx.Style.Highlight(cmd.InputName),
+	)
+
+	ticker := time.NewTicker(textWatchInterval)
+	defer ticker.Stop()
+
+	var lastModTime time.Time
+	lastSize := int64(-1)
+	// poll returns the text of the file if it has changed since the last successful read
+	poll := func() (string, bool, error) {
+		info, err := os.Stat(cmd.FromFile)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to read text: %w", err)
+		}
+		if info.ModTime().Equal(lastModTime) && info.Size() == lastSize {
+			return "", false, nil
+		}
+		text, err := readTextFile(cmd.FromFile)
+		if err != nil {
+			return "", false, err
+		}
+		lastModTime, lastSize = info.ModTime(), info.Size()
+		return text, true, nil
+	}
+
+	var lastErr string
+	for {
+		text, changed, err := poll()
+		switch {
+		case err != nil:
+			// Editors may replace the file by removing and recreating it, so read errors are
+			// reported once and retried on the next poll
+			if err.Error() != lastErr {
+				lastErr = err.Error()
+				fmt.Fprintf(ctx.Out, "%s, retrying.\n", ctx.Style.Error(lastErr))
+			}
+		case changed:
+			lastErr = ""
+			if err := setInputText(ctx, cmd.InputName, text); err != nil {
+				return err
+			}
+			fmt.Fprintf(
+				ctx.Out,
+				"Updated text for source %s to: %s\n",
+				ctx.Style.Highlight(cmd.InputName),
+				text,
+			)
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// renderTextTemplate executes tmpl as a text/template with vars as its data.
+// Referencing a variable which is not set is an error.
+func renderTextTemplate(tmpl string, vars map[string]string) (string, error) {
+	t, err := template.New("text").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+	if vars == nil {
+		vars = map[string]string{}
+	}
+
+	var b strings.Builder
+	if err := t.Execute(&b, vars); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// readTextFile reads the text of a text source from path, without trailing line breaks.
+func readTextFile(path string) (string, error) {
+	data, err := os.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return "", fmt.Errorf("failed to read text: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// textTickInterval is how often the text of a timer or clock is recomputed.
+// The text input is only updated when the text changes.
+const textTickInterval = 100 * time.Millisecond
+
+// TextTimerCmd provides a command to show a countdown or count-up timer in a text input.
+type TextTimerCmd struct {
+	InputName string `arg:"" help:"Name of the text source."`
+
+	Countdown time.Duration `flag:"" help:"Count down from this duration, otherwise count up from zero."`
+	Format    string        `flag:"" help:"Format of the text, {{hh}}, {{mm}} and {{ss}} are replaced by hours, minutes and seconds, {{time}} by MM:SS or HH:MM:SS." default:"{{time}}"`
+	OnFinish  string        `flag:"" help:"gobs-cli command to run when the countdown finishes (e.g. 'scene switch Live')."`
+}
+
+// Run executes the command to show a timer in a text input.
+func (cmd *TextTimerCmd) Run(ctx *context) error {
+	if cmd.Countdown < 0 {
+		return errors.New("countdown must not be negative")
+	}
+	if cmd.OnFinish != "" && cmd.Countdown == 0 {
+		return errors.New("--on-finish requires --countdown")
+	}
+	// Parse the command up front so a mistake is reported before the countdown starts
+	var onFinish *kong.Context
+	if cmd.OnFinish != "" {
+		args, err := splitCommandLine(cmd.OnFinish)
+		if err != nil {
+			return fmt.Errorf("failed to parse --on-finish: %w", err)
+		}
+		if onFinish, err = parseCommandLine(ctx, args); err != nil {
+			return fmt.Errorf("failed to parse --on-finish: %w", err)
+		}
+	}
+
+	resp, err := getTextInputSettings(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	warnReadsFromFile(ctx, cmd.InputName, resp.InputKind, resp.InputSettings)
+
+	start := time.Now()
+	if cmd.Countdown > 0 {
+		fmt.Fprintf(
+			ctx.Out,
+			"Counting down from %s on source %s. Press Ctrl+C to stop.\n",
+			formatMillisecondsToTimeString(float64(cmd.Countdown.Milliseconds())),
+			ctx.Style.Highlight(cmd.InputName),
+		)
+	} else {
+		fmt.Fprintf(
+			ctx.Out,
+			"Counting up on source %s. Press Ctrl+C to stop.\n",
+			ctx.Style.Highlight(cmd.InputName),
+		)
+	}
+
+	finished, err := updateTextUntilInterrupted(ctx, cmd.InputName, func(now time.Time) (string, bool) {
+		elapsed := now.Sub(start)
+		if cmd.Countdown == 0 {
+			return formatTimer(cmd.Format, elapsed), false
+		}
+		// Round up, so the countdown shows its full duration first and reaches zero as it finishes
+		remaining := (cmd.Countdown - elapsed + time.Second - 1).Truncate(time.Second)
+		return formatTimer(cmd.Format, max(remaining, 0)), remaining <= 0
+	})
+	if err != nil || !finished {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Out, "Countdown on source %s finished.\n", ctx.Style.Highlight(cmd.InputName))
+	if onFinish != nil {
+		return runCommandLine(ctx, onFinish)
+	}
+	return nil
+}
+
+// formatTimer replaces the {{hh}}, {{mm}}, {{ss}} and {{time}} placeholders in format with d.
+// If format has no {{hh}} placeholder {{mm}} is the total number of minutes.
+func formatTimer(format string, d time.Duration) string {
+	totalSeconds := int(d / time.Second)
+	hours := totalSeconds / 3600
+	minutes := (totalSeconds % 3600) / 60
+	if !strings.Contains(format, "{{hh}}") {
+		minutes = totalSeconds / 60
+	}
+
+	return strings.NewReplacer(
+		"{{hh}}", fmt.Sprintf("%02d", hours),
+		"{{mm}}", fmt.Sprintf("%02d", minutes),
+		"{{ss}}", fmt.Sprintf("%02d", totalSeconds%60),
+		"{{time}}", formatMillisecondsToTimeString(float64(d.Milliseconds())),
+	).Replace(format)
+}
+
+// TextClockCmd provides a command to show the current time in a text input.
+type TextClockCmd struct {
+	InputName string `arg:"" help:"Name of the text source."`
+
+	Format string `flag:"" help:"Format of the time as a Go time layout (e.g. 15:04:05, 3:04 PM)."              default:"15:04:05"`
+	TZ     string `flag:"" help:"Time zone of the clock (e.g. Europe/London), defaults to the local time zone."                    name:"tz"`
+}
+
+// Run executes the command to show the current time in a text input.
+func (cmd *TextClockCmd) Run(ctx *context) error {
+	location := time.Local
+	if cmd.TZ != "" {
+		var err error
+		if location, err = time.LoadLocation(cmd.TZ); err != nil {
+			return fmt.Errorf("failed to load time zone %s: %w", ctx.Style.Error(cmd.TZ), err)
+		}
+	}
+
+	resp, err := getTextInputSettings(ctx, cmd.InputName)
+	if err != nil {
+		return err
+	}
+	warnReadsFromFile(ctx, cmd.InputName, resp.InputKind, resp.InputSettings)
+
+	fmt.Fprintf(
+		ctx.Out,
+		"Showing the time in %s on source %s. Press Ctrl+C to stop.\n",
+		location,
+		ctx.Style.Highlight(cmd.InputName),
+	)
+
+	_, err = updateTextUntilInterrupted(ctx, cmd.InputName, func(now time.Time) (string, bool) {
+		return now.In(location).Format(cmd.Format), false
+	})
+	return err
+}
+
+// updateTextUntilInterrupted sets the text of the input to the result of render every
+// textTickInterval, until render reports it is done or the command is interrupted.
+// It reports whether render finished.
+func updateTextUntilInterrupted(
+	ctx *context,
+	inputName string,
+	render func(now time.Time) (text string, done bool),
+) (bool, error) {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	ticker := time.NewTicker(textTickInterval)
+	defer ticker.Stop()
+
+	var last string
+	for first := true; ; first = false {
+		text, done := render(time.Now())
+		if first || text != last {
+			if err := setInputText(ctx, inputName, text); err != nil {
+				return false, err
+			}
+			last = text
+		}
+		if done {
+			return true, nil
+		}
+
+		select {
+		case <-interrupt:
+			return false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// getTextInputSettings returns the settings of the input, which must be a text input.
+func getTextInputSettings(ctx *context, inputName string) (*inputs.GetInputSettingsResponse, error) {
+	params := inputs.NewGetInputSettingsParams()
+	params = withInput(params, inputName)
+	resp, err := ctx.Client.Inputs.GetInputSettings(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get input settings: %w", err)
+	}
+
+	// Check if the input is a text input
+	kind := resp.InputKind
+	if !strings.HasPrefix(kind, "text_") {
+		return nil, fmt.Errorf("input %s is of %s", inputName, kind)
+	}
+	return resp, nil
+}
+
+// warnReadsFromFile warns that text set on the input is not shown, if OBS reads the text of the
+// input from a file according to settings.
+func warnReadsFromFile(ctx *context, inputName, kind string, settings map[string]any) {
+	keys, ok := lookupTextKind(kind)
+	if !ok {
+		return
+	}
+	if fromFile, _ := settings[keys.fromFile].(bool); fromFile {
+		fmt.Fprintf(
+			ctx.Out,
+			"Source %s reads its text from a file, the text is not shown until --no-obs-read-file is set.\n",
+			ctx.Style.Highlight(inputName),
+		)
+	}
+}
+
+// setInputText sets the text of the input.
+func setInputText(ctx *context, inputName string, text string) error {
+	return setTextInputSettings(ctx, inputName, map[string]any{"text": text})
+}
+
+// setTextInputSettings applies settings on top of the current settings of the input.
+func setTextInputSettings(ctx *context, inputName string, settings map[string]any) error {
+	if _, err := ctx.Client.Inputs.SetInputSettings(&inputs.SetInputSettingsParams{
+		InputName:     &inputName,
+		InputSettings: settings,
+	}); err != nil {
+		return fmt.Errorf("failed to update text for source %s: %w", inputName, err)
+	}
+	return nil
+}
+
+// textKindSettings holds the setting keys of a text source kind.
+// An empty key means the kind has no such setting.
+type textKindSettings struct {
+	colours       []string
+	outline       string
+	outlineSize   string
+	outlineColour string
+	fromFile      string
+	file          string
+}
+
+// textKinds maps unversioned text source kinds to their setting keys.
+// All of them keep the font face and size in a "font" object.
+// nolint: misspell
+var textKinds = map[string]textKindSettings{
+	"text_gdiplus": {
+		colours:       []string{"color"},
+		outline:       "outline",
+		outlineSize:   "outline_size",
+		outlineColour: "outline_color",
+		fromFile:      "read_from_file",
+		file:          "file",
+	},
+	// FreeType 2 draws a vertical gradient from color1 to color2
+	"text_ft2_source": {
+		colours:  []string{"color1", "color2"},
+		outline:  "outline",
+		fromFile: "from_file",
+		file:     "text_file",
+	},
+	"text_pango_source": {
+		colours:       []string{"color1", "color2"},
+		outline:       "outline",
+		outlineSize:   "outline_width",
+		outlineColour: "outline_color",
+		fromFile:      "from_file",
+		file:          "text_file",
+	},
+}
+
+// lookupTextKind returns the setting keys of a text source kind, such as text_gdiplus_v3.
+func lookupTextKind(kind string) (textKindSettings, bool) {
+	for base, keys := range textKinds {
+		if kind == base || strings.HasPrefix(kind, base+"_v") {
+			return keys, true
+		}
+	}
+	return textKindSettings{}, false
+}
+
+// TextStyleFlags holds the flags to style a text input.
+type TextStyleFlags struct {
+	FontFace      string `flag:"" help:"Font face (e.g. Arial)."`
+	FontSize      int    `flag:"" help:"Font size."`
+	Colour        string `flag:"" help:"Text colour as #RRGGBB or #AARRGGBB."                                      placeholder:"#RRGGBB"`
+	Outline       *bool  `flag:"" help:"Draw an outline around the text."                                                                negatable:""`
+	OutlineSize   int    `flag:"" help:"Outline size, not supported by FreeType 2 text."`
+	OutlineColour string `flag:"" help:"Outline colour as #RRGGBB or #AARRGGBB, not supported by FreeType 2 text." placeholder:"#RRGGBB"`
+	ObsReadFile   *bool  `flag:"" help:"Let OBS read the text from the file set with --obs-file."                                        negatable:""`
+	ObsFile       string `flag:"" help:"File for OBS to read the text from, enables --obs-read-file."              placeholder:"FILE"`
+}
+
+// settings returns the input settings for the flags which are set, given the kind and
+// current settings of the input.
+func (f *TextStyleFlags) settings(
+	ctx *context,
+	kind string,
+	current map[string]any,
+) (map[string]any, error) {
+	settings := make(map[string]any)
+	if f.FontFace == "" && f.FontSize == 0 && f.Colour == "" && f.Outline == nil &&
+		f.OutlineSize == 0 && f.OutlineColour == "" && f.ObsReadFile == nil && f.ObsFile == "" {
+		return settings, nil
+	}
+
+	keys, ok := lookupTextKind(kind)
+	if !ok {
+		return nil, fmt.Errorf("styling is not supported for text sources of kind %s", ctx.Style.Error(kind))
+	}
+	unsupported := func(flag string) error {
+		return fmt.Errorf("%s is not supported for text sources of kind %s", flag, ctx.Style.Error(kind))
+	}
+
+	if f.FontFace != "" || f.FontSize != 0 {
+		// The font is replaced as a whole, so start from the current or default font
+		defaults, err := textDefaultSettings(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+		font := textFont(defaults, current)
+		if f.FontFace != "" {
+			font["face"] = f.FontFace
+		}
+		if f.FontSize != 0 {
+			font["size"] = f.FontSize
+		}
+		settings["font"] = font
+	}
+
+	if f.Colour != "" {
+		colour, err := parseColour(f.Colour)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys.colours {
+			settings[key] = colour
+		}
+	}
+
+	if f.Outline != nil {
+		settings[keys.outline] = *f.Outline
+	}
+	if f.OutlineSize != 0 {
+		if keys.outlineSize == "" {
+			return nil, unsupported("--outline-size")
+		}
+		settings[keys.outlineSize] = f.OutlineSize
+	}
+	if f.OutlineColour != "" {
+		if keys.outlineColour == "" {
+			return nil, unsupported("--outline-colour")
+		}
+		colour, err := parseColour(f.OutlineColour)
+		if err != nil {
+			return nil, err
+		}
+		settings[keys.outlineColour] = colour
+	}
+
+	if f.ObsFile != "" {
+		settings[keys.file] = f.ObsFile
+		settings[keys.fromFile] = true
+	}
+	if f.ObsReadFile != nil {
+		settings[keys.fromFile] = *f.ObsReadFile
+	}
+	return settings, nil
+}
+
+// textDefaultSettings returns the default settings of a text source kind.
+func textDefaultSettings(ctx *context, kind string) (map[string]any, error) {
+	resp, err := ctx.Client.Inputs.GetInputDefaultSettings(
+		inputs.NewGetInputDefaultSettingsParams().WithInputKind(kind),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default settings for input kind %s: %w", kind, err)
+	}
+	return resp.DefaultInputSettings, nil
+}
+
+// textFont returns a copy of the current font of a text input merged over the default font.
+func textFont(defaults, current map[string]any) map[string]any {
+	font := make(map[string]any)
+	if defaultFont, ok := defaults["font"].(map[string]any); ok {
+		maps.Copy(font, defaultFont)
+	}
+	if currentFont, ok := current["font"].(map[string]any); ok {
+		maps.Copy(font, currentFont)
+	}
+	return font
+}
+
+// parseColour parses a colour given as #RRGGBB or #AARRGGBB, with or without the #, into
+// the 0xAABBGGRR form used by OBS. Colours without alpha are opaque.
+func parseColour(s string) (int64, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 6 {
+		hex = "ff" + hex
+	}
+	if len(hex) != 8 {
+		return 0, fmt.Errorf("invalid colour %s, expected #RRGGBB or #AARRGGBB", s)
+	}
+	argb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid colour %s, expected #RRGGBB or #AARRGGBB", s)
+	}
+
+	a, r, g, b := argb>>24&0xff, argb>>16&0xff, argb>>8&0xff, argb&0xff
+	return int64(a<<24 | b<<16 | g<<8 | r), nil // nolint: gosec
+}
+
+// formatColour formats a colour in the 0xAABBGGRR form used by OBS as #RRGGBB,
+// or #AARRGGBB if it is not opaque.
+func formatColour(value any) string {
+	v, ok := value.(float64)
+	if !ok {
+		return formatSettingValue(value)
+	}
+	abgr := uint32(int64(v)) // nolint: gosec
+	a, b, g, r := abgr>>24&0xff, abgr>>16&0xff, abgr>>8&0xff, abgr&0xff
+	if a == 0xff {
+		return fmt.Sprintf("#%02X%02X%02X", r, g, b)
+	}
+	return fmt.Sprintf("#%02X%02X%02X%02X", a, r, g, b)
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderTextTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		vars     map[string]string
+		expected string
+	}{
+		{"Plain", "Starting soon", nil, "Starting soon"},
+		{"Variables", "Next: {{.title}} at {{.time}}", map[string]string{"title": "News", "time": "18:00"}, "Next: News at 18:00"},
+		{"Function", "{{printf \"%s!\" .title}}", map[string]string{"title": "Live"}, "Live!"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := renderTextTemplate(test.template, test.vars)
+			if err != nil {
+				t.Fatalf("Failed to render template: %v", err)
+			}
+			if result != test.expected {
+				t.Errorf("Expected '%s', got '%s'", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestRenderTextTemplateInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{"MissingVariable", "Next: {{.title}}"},
+		{"Unclosed", "Next: {{.title"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := renderTextTemplate(test.template, nil); err == nil {
+				t.Errorf("Expected error for template '%s', but got none", test.template)
+			}
+		})
+	}
+}
+
+func TestReadTextFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("Line one\nLine two\r\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write text file: %v", err)
+	}
+
+	text, err := readTextFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read text file: %v", err)
+	}
+	if text != "Line one\nLine two" {
+		t.Errorf("Expected 'Line one\\nLine two', got '%s'", text)
+	}
+}
+
+func TestFormatTimer(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		d        time.Duration
+		expected string
+	}{
+		{"Time", "{{time}}", 5 * time.Minute, "05:00"},
+		{"TimeHours", "{{time}}", 90 * time.Minute, "01:30:00"},
+		{"MinutesSeconds", "Starting in {{mm}}:{{ss}}", 4*time.Minute + 7*time.Second, "Starting in 04:07"},
+		{"TotalMinutes", "{{mm}}:{{ss}}", 90 * time.Minute, "90:00"},
+		{"Hours", "{{hh}}:{{mm}}:{{ss}}", 90*time.Minute + 500*time.Millisecond, "01:30:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := formatTimer(test.format, test.d)
+			if result != test.expected {
+				t.Errorf("Expected '%s', got '%s'", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestTimerOnFinishParse(t *testing.T) {
+	var out bytes.Buffer
+	context := newContext(nil, &out, StyleConfig{})
+
+	kctx, err := parseCommandLine(context, []string{"scene", "switch", "Live"})
+	if err != nil {
+		t.Fatalf("Failed to parse --on-finish command: %v", err)
+	}
+	if kctx.Command() != "scene switch <new-scene>" {
+		t.Fatalf("Expected 'scene switch <new-scene>' to be selected, got '%s'", kctx.Command())
+	}
+}
+
+func TestTimerOnFinishInvalid(t *testing.T) {
+	var out bytes.Buffer
+	context := newContext(nil, &out, StyleConfig{})
+
+	// The command is parsed before the countdown starts, so no connection to OBS is needed
+	cmd := &TextTimerCmd{
+		InputName: "Countdown",
+		Countdown: time.Minute,
+		OnFinish:  "scene swtich Live",
+	}
+	err := cmd.Run(context)
+	if err == nil {
+		t.Fatal("Expected an error for an unknown --on-finish command")
+	}
+	if !strings.Contains(err.Error(), "failed to parse --on-finish") {
+		t.Fatalf("Expected error to mention --on-finish, got '%s'", err.Error())
+	}
+}
+
+func TestWarnReadsFromFile(t *testing.T) {
+	var out bytes.Buffer
+	context := newContext(nil, &out, StyleConfig{})
+
+	warnReadsFromFile(context, "Ticker", "text_gdiplus_v3", map[string]any{"read_from_file": false})
+	if out.String() != "" {
+		t.Fatalf("Expected no warning, got '%s'", out.String())
+	}
+
+	warnReadsFromFile(context, "Ticker", "text_ft2_source_v2", map[string]any{"from_file": true})
+	if !strings.Contains(out.String(), "Source Ticker reads its text from a file") {
+		t.Fatalf("Expected a warning that the source reads from a file, got '%s'", out.String())
+	}
+}
+
+func TestParseColour(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"#FF0000", 0xFF0000FF},
+		{"00ff00", 0xFF00FF00},
+		{"#0000FF", 0xFFFF0000},
+		{"#80FFFFFF", 0x80FFFFFF},
+		{"#80102030", 0x80302010},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := parseColour(test.input)
+			if err != nil {
+				t.Fatalf("Failed to parse colour: %v", err)
+			}
+			if result != test.expected {
+				t.Errorf("Expected %#x, got %#x", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseColourInvalid(t *testing.T) {
+	for _, input := range []string{"", "#FFF", "#GGGGGG", "#FF00FF00FF"} {
+		if _, err := parseColour(input); err == nil {
+			t.Errorf("Expected error for colour '%s', but got none", input)
+		}
+	}
+}
+
+func TestFormatColour(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{float64(0xFF0000FF), "#FF0000"},
+		{float64(0x80302010), "#80102030"},
+		{"not a colour", "not a colour"},
+	}
+
+	for _, test := range tests {
+		result := formatColour(test.input)
+		if result != test.expected {
+			t.Errorf("Expected '%s', got '%s'", test.expected, result)
+		}
+	}
+}
+
+func TestLookupTextKind(t *testing.T) {
+	tests := []struct {
+		kind     string
+		fromFile string
+		ok       bool
+	}{
+		{"text_gdiplus", "read_from_file", true},
+		{"text_gdiplus_v3", "read_from_file", true},
+		{"text_ft2_source_v2", "from_file", true},
+		{"text_gdiplusplus", "", false},
+		{"color_source_v3", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.kind, func(t *testing.T) {
+			keys, ok := lookupTextKind(test.kind)
+			if ok != test.ok || keys.fromFile != test.fromFile {
+				t.Errorf("Expected (%s, %t), got (%s, %t)", test.fromFile, test.ok, keys.fromFile, ok)
+			}
+		})
+	}
+}
+
+func TestTextFont(t *testing.T) {
+	defaults := map[string]any{"font": map[string]any{"face": "Arial", "size": float64(256)}}
+	current := map[string]any{"font": map[string]any{"size": float64(72)}}
+
+	font := textFont(defaults, current)
+	if font["face"] != "Arial" || font["size"] != float64(72) {
+		t.Errorf("Expected face Arial and size 72, got %v", font)
+	}
+
+	font["face"] = "Sans"
+	if defaultFont, ok := defaults["font"].(map[string]any); !ok || defaultFont["face"] != "Arial" {
+		t.Error("Expected the default font to be left unchanged")
+	}
+}
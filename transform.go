@@ -0,0 +1,340 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"github.com/andreykaipov/goobs/api/typedefs"
+)
+
+// transformValue is a numeric transform flag which may be absolute (e.g. 50)
+// or relative to the current value (e.g. +=50, -=90, *=1.5).
+type transformValue struct {
+	set   bool
+	op    string
+	value float64
+}
+
+// Decode implements kong.MapperValue.
+func (v *transformValue) Decode(ctx *kong.DecodeContext) error {
+	// Negative (-90) and subtracting (-=90) values start with a hyphen, which kong
+	// would otherwise take for a short flag
+	if t := ctx.Scan.Peek(); t.Type == kong.UntypedToken {
+		if s, ok := t.Value.(string); ok && strings.HasPrefix(s, "-") && v.parse(s) == nil {
+			ctx.Scan.Pop()
+			return nil
+		}
+	}
+
+	var s string
+	if err := ctx.Scan.PopValueInto("value", &s); err != nil {
+		return err
+	}
+	return v.parse(s)
+}
+
+func (v *transformValue) parse(s string) error {
+	op := ""
+	for _, prefix := range []string{"+=", "-=", "*="} {
+		if strings.HasPrefix(s, prefix) {
+			op = prefix
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(trimPrefix(s, op)), 64)
+	if err != nil {
+		return fmt.Errorf("invalid transform value %q: expected a number, +=N, -=N or *=N", s)
+	}
+
+	v.set = true
+	v.op = op
+	v.value = value
+	return nil
+}
+
+// applyTo updates dst with the value, leaving it untouched if the flag was not provided.
+func (v transformValue) applyTo(dst *float64) {
+	if !v.set {
+		return
+	}
+
+	switch v.op {
+	case "+=":
+		*dst += v.value
+	case "-=":
+		*dst -= v.value
+	case "*=":
+		*dst *= v.value
+	default:
+		*dst = v.value
+	}
+}
+
+// alignments maps human readable alignment names to OBS alignment bitmasks.
+var alignments = map[string]float64{
+	"center":       0,
+	"left":         1,
+	"right":        2,
+	"top":          4,
+	"top-left":     5,
+	"top-right":    6,
+	"bottom":       8,
+	"bottom-left":  9,
+	"bottom-right": 10,
+}
+
+// boundsTypes maps human readable bounds type names to OBS bounds types.
+var boundsTypes = map[string]string{
+	"none":            "OBS_BOUNDS_NONE",
+	"stretch":         "OBS_BOUNDS_STRETCH",
+	"fit":             "OBS_BOUNDS_SCALE_INNER",
+	"fill":            "OBS_BOUNDS_SCALE_OUTER",
+	"scale-to-width":  "OBS_BOUNDS_SCALE_TO_WIDTH",
+	"scale-to-height": "OBS_BOUNDS_SCALE_TO_HEIGHT",
+	"max-only":        "OBS_BOUNDS_MAX_ONLY",
+}
+
+// alignmentNames lists the alignment names in the order they are shown in errors.
+const alignmentNames = "top-left, top, top-right, left, center, right, bottom-left, bottom, bottom-right"
+
+// alignmentValue is an alignment flag given either as a name (e.g. top-left)
+// or as a raw OBS alignment bitmask (e.g. 5).
+type alignmentValue struct {
+	set   bool
+	value float64
+}
+
+// Decode implements kong.MapperValue.
+func (v *alignmentValue) Decode(ctx *kong.DecodeContext) error {
+	var s string
+	if err := ctx.Scan.PopValueInto("alignment", &s); err != nil {
+		return err
+	}
+	return v.parse(s)
+}
+
+func (v *alignmentValue) parse(s string) error {
+	if value, ok := alignments[s]; ok {
+		v.set = true
+		v.value = value
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid alignment %q: expected one of %s or an OBS alignment bitmask", s, alignmentNames)
+	}
+	v.set = true
+	v.value = float64(n)
+	return nil
+}
+
+// applyTo sets dst to the alignment, leaving it untouched if the flag was not provided.
+func (v alignmentValue) applyTo(dst *float64) {
+	if v.set {
+		*dst = v.value
+	}
+}
+
+// boundsTypeValue is a bounds type flag given either as a name (e.g. fit)
+// or as a raw OBS bounds type (e.g. OBS_BOUNDS_SCALE_INNER).
+type boundsTypeValue string
+
+// Decode implements kong.MapperValue.
+func (v *boundsTypeValue) Decode(ctx *kong.DecodeContext) error {
+	var s string
+	if err := ctx.Scan.PopValueInto("bounds type", &s); err != nil {
+		return err
+	}
+	return v.parse(s)
+}
+
+func (v *boundsTypeValue) parse(s string) error {
+	if value, ok := boundsTypes[s]; ok {
+		*v = boundsTypeValue(value)
+		return nil
+	}
+	for _, value := range boundsTypes {
+		if value == s {
+			*v = boundsTypeValue(value)
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"invalid bounds type %q: expected one of none, stretch, fit, fill, scale-to-width, scale-to-height, max-only or an OBS_BOUNDS_* value",
+		s,
+	)
+}
+
+// alignmentName returns the name of an OBS alignment bitmask, or the raw value if it has none.
+func alignmentName(alignment float64) string {
+	for name, value := range alignments {
+		if value == alignment {
+			return name
+		}
+	}
+	return fmt.Sprintf("%.0f", alignment)
+}
+
+// boundsTypeName returns the name of an OBS bounds type, or the raw value if it has none.
+func boundsTypeName(boundsType string) string {
+	for name, value := range boundsTypes {
+		if value == boundsType {
+			return name
+		}
+	}
+	return boundsType
+}
+
+// rect is an area of the canvas in pixels.
+type rect struct {
+	x, y, width, height float64
+}
+
+// parseGrid parses a grid size given as COLSxROWS (e.g. 2x3), reporting false
+// unless both parts are positive whole numbers.
+func parseGrid(s string) (cols, rows int, ok bool) {
+	colsStr, rowsStr, found := strings.Cut(s, "x")
+	if !found {
+		return 0, 0, false
+	}
+	cols, err := strconv.Atoi(colsStr)
+	if err != nil || cols < 1 {
+		return 0, 0, false
+	}
+	rows, err = strconv.Atoi(rowsStr)
+	if err != nil || rows < 1 {
+		return 0, 0, false
+	}
+	return cols, rows, true
+}
+
+// gridCells divides the canvas into cols x rows cells separated by margin, in row-major order.
+// It returns an error if the margin leaves no room for the cells.
+func gridCells(cols, rows int, width, height, margin float64) ([]rect, error) {
+	if margin < 0 {
+		return nil, fmt.Errorf("margin must not be negative")
+	}
+	cellWidth := (width - margin*float64(cols+1)) / float64(cols)
+	cellHeight := (height - margin*float64(rows+1)) / float64(rows)
+	if cellWidth <= 0 || cellHeight <= 0 {
+		return nil, fmt.Errorf("margin of %.0f leaves no room for %dx%d cells on a %.0fx%.0f canvas",
+			margin, cols, rows, width, height)
+	}
+
+	cells := make([]rect, 0, cols*rows)
+	for r := range rows {
+		for c := range cols {
+			cells = append(cells, rect{
+				x:      margin + float64(c)*(cellWidth+margin),
+				y:      margin + float64(r)*(cellHeight+margin),
+				width:  cellWidth,
+				height: cellHeight,
+			})
+		}
+	}
+	return cells, nil
+}
+
+// pipCells returns a full canvas cell followed by a picture-in-picture cell of the given
+// fraction of the canvas, inset by margin in the given corner.
+// It returns an error if the picture-in-picture cell does not fit within the canvas.
+func pipCells(corner string, size, width, height, margin float64) ([]rect, error) {
+	if margin < 0 {
+		return nil, fmt.Errorf("margin must not be negative")
+	}
+	pip := rect{width: width * size, height: height * size}
+	if pip.width <= 0 || pip.height <= 0 || pip.width+margin > width || pip.height+margin > height {
+		return nil, fmt.Errorf("picture-in-picture of size %g with a margin of %.0f does not fit a %.0fx%.0f canvas",
+			size, margin, width, height)
+	}
+
+	switch corner {
+	case "top-left", "bottom-left":
+		pip.x = margin
+	default:
+		pip.x = width - pip.width - margin
+	}
+	switch corner {
+	case "top-left", "top-right":
+		pip.y = margin
+	default:
+		pip.y = height - pip.height - margin
+	}
+
+	return []rect{{width: width, height: height}, pip}, nil
+}
+
+// fitToCell scales and positions a transform so its (cropped) source fits centred within cell,
+// preserving its aspect ratio.
+func fitToCell(transform *typedefs.SceneItemTransform, cell rect) {
+	sourceWidth := transform.SourceWidth - transform.CropLeft - transform.CropRight
+	sourceHeight := transform.SourceHeight - transform.CropTop - transform.CropBottom
+	if sourceWidth <= 0 || sourceHeight <= 0 {
+		return
+	}
+
+	scale := min(cell.width/sourceWidth, cell.height/sourceHeight)
+
+	transform.Alignment = alignments["top-left"]
+	transform.BoundsType = boundsTypes["none"]
+	transform.Rotation = 0
+	transform.ScaleX = scale
+	transform.ScaleY = scale
+	transform.PositionX = cell.x + (cell.width-sourceWidth*scale)/2
+	transform.PositionY = cell.y + (cell.height-sourceHeight*scale)/2
+}
+
+// alignmentOffset returns the offset of an alignment point from the top-left of a width x height box.
+func alignmentOffset(alignment, width, height float64) (float64, float64) {
+	mask := int(alignment)
+
+	x := width / 2
+	switch {
+	case mask&int(alignments["left"]) != 0:
+		x = 0
+	case mask&int(alignments["right"]) != 0:
+		x = width
+	}
+	y := height / 2
+	switch {
+	case mask&int(alignments["top"]) != 0:
+		y = 0
+	case mask&int(alignments["bottom"]) != 0:
+		y = height
+	}
+	return x, y
+}
+
+// composeGroupTransform converts the transform of an item within a group into the
+// equivalent transform within the scene containing the group.
+func composeGroupTransform(
+	group, child typedefs.SceneItemTransform,
+) typedefs.SceneItemTransform {
+	rad := group.Rotation * math.Pi / 180
+	sin, cos := math.Sin(rad), math.Cos(rad)
+	rotate := func(x, y float64) (float64, float64) {
+		return x*cos - y*sin, x*sin + y*cos
+	}
+
+	// The group's content is positioned relative to its top-left corner
+	ox, oy := alignmentOffset(group.Alignment, group.Width, group.Height)
+	ox, oy = rotate(ox, oy)
+	originX, originY := group.PositionX-ox, group.PositionY-oy
+
+	cx, cy := rotate(child.PositionX*group.ScaleX, child.PositionY*group.ScaleY)
+
+	result := child
+	result.PositionX = originX + cx
+	result.PositionY = originY + cy
+	result.ScaleX = child.ScaleX * group.ScaleX
+	result.ScaleY = child.ScaleY * group.ScaleY
+	result.Rotation = math.Mod(child.Rotation+group.Rotation, 360)
+	result.BoundsWidth = child.BoundsWidth * group.ScaleX
+	result.BoundsHeight = child.BoundsHeight * group.ScaleY
+	return result
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/andreykaipov/goobs/api/typedefs"
+)
+
+func TestTransformValueApplyTo(t *testing.T) {
+	tests := []struct {
+		input    string
+		current  float64
+		expected float64
+	}{
+		{"0", 250, 0},
+		{"-90", 45, -90},
+		{"+=50", 100, 150},
+		{"-=90", 45, -45},
+		{"*=1.5", 2, 3},
+	}
+
+	for _, test := range tests {
+		var v transformValue
+		if err := v.parse(test.input); err != nil {
+			t.Fatalf("Failed to parse '%s': %v", test.input, err)
+		}
+		result := test.current
+		v.applyTo(&result)
+		if result != test.expected {
+			t.Errorf("Expected '%s' applied to %v to be %v but got %v",
+				test.input, test.current, test.expected, result)
+		}
+	}
+}
+
+func TestTransformValueNotSet(t *testing.T) {
+	var v transformValue
+	result := 42.0
+	v.applyTo(&result)
+	if result != 42.0 {
+		t.Errorf("Expected unset value to leave 42 untouched but got %v", result)
+	}
+}
+
+func TestTransformValueInvalid(t *testing.T) {
+	for _, input := range []string{"", "abc", "/=2", "+=abc"} {
+		var v transformValue
+		if err := v.parse(input); err == nil {
+			t.Errorf("Expected error parsing '%s' but got none", input)
+		}
+	}
+}
+
+func TestAlignmentName(t *testing.T) {
+	tests := []struct {
+		input    float64
+		expected string
+	}{
+		{0, "center"},
+		{5, "top-left"},
+		{10, "bottom-right"},
+		{3, "3"},
+	}
+
+	for _, test := range tests {
+		result := alignmentName(test.input)
+		if result != test.expected {
+			t.Errorf("Expected '%s' but got '%s'", test.expected, result)
+		}
+	}
+}
+
+func TestBoundsTypeName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"OBS_BOUNDS_NONE", "none"},
+		{"OBS_BOUNDS_SCALE_INNER", "fit"},
+		{"OBS_BOUNDS_SCALE_TO_WIDTH", "scale-to-width"},
+		{"OBS_BOUNDS_UNKNOWN", "OBS_BOUNDS_UNKNOWN"},
+	}
+
+	for _, test := range tests {
+		result := boundsTypeName(test.input)
+		if result != test.expected {
+			t.Errorf("Expected '%s' but got '%s'", test.expected, result)
+		}
+	}
+}
+
+func TestGridCells(t *testing.T) {
+	cells, err := gridCells(2, 2, 1920, 1080, 0)
+	if err != nil {
+		t.Fatalf("Failed to compute grid cells: %v", err)
+	}
+	expected := []rect{
+		{0, 0, 960, 540},
+		{960, 0, 960, 540},
+		{0, 540, 960, 540},
+		{960, 540, 960, 540},
+	}
+	if len(cells) != len(expected) {
+		t.Fatalf("Expected %d cells but got %d", len(expected), len(cells))
+	}
+	for i := range expected {
+		if cells[i] != expected[i] {
+			t.Errorf("Expected cell %d to be %v but got %v", i, expected[i], cells[i])
+		}
+	}
+
+	cells, err = gridCells(2, 1, 1920, 1080, 20)
+	if err != nil {
+		t.Fatalf("Failed to compute grid cells: %v", err)
+	}
+	if cells[1] != (rect{970, 20, 930, 1040}) {
+		t.Errorf("Expected margin to be applied, got %v", cells[1])
+	}
+}
+
+func TestPipCells(t *testing.T) {
+	cells, err := pipCells("bottom-right", 0.25, 1920, 1080, 40)
+	if err != nil {
+		t.Fatalf("Failed to compute pip cells: %v", err)
+	}
+	if cells[0] != (rect{0, 0, 1920, 1080}) {
+		t.Errorf("Expected first cell to cover the canvas, got %v", cells[0])
+	}
+	if cells[1] != (rect{1400, 770, 480, 270}) {
+		t.Errorf("Expected pip cell in the bottom right corner, got %v", cells[1])
+	}
+}
+
+func TestLayoutCellsInvalidMargin(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() ([]rect, error)
+	}{
+		{"GridMarginTooLarge", func() ([]rect, error) { return gridCells(2, 2, 1920, 1080, 400) }},
+		{"GridMarginFillsCanvas", func() ([]rect, error) { return gridCells(1, 1, 1920, 1080, 540) }},
+		{"GridNegativeMargin", func() ([]rect, error) { return gridCells(2, 2, 1920, 1080, -10) }},
+		{"PipMarginTooLarge", func() ([]rect, error) { return pipCells("top-left", 0.5, 1920, 1080, 600) }},
+		{"PipNegativeMargin", func() ([]rect, error) { return pipCells("top-left", 0.5, 1920, 1080, -10) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if cells, err := test.fn(); err == nil {
+				t.Errorf("Expected error, but got cells %v", cells)
+			}
+		})
+	}
+}
+
+func TestFitToCell(t *testing.T) {
+	transform := &typedefs.SceneItemTransform{
+		SourceWidth:  1920,
+		SourceHeight: 1080,
+		Rotation:     45,
+	}
+	fitToCell(transform, rect{0, 0, 960, 960})
+
+	if transform.ScaleX != 0.5 || transform.ScaleY != 0.5 {
+		t.Errorf("Expected scale of 0.5 but got %v, %v", transform.ScaleX, transform.ScaleY)
+	}
+	if transform.PositionX != 0 || transform.PositionY != 210 {
+		t.Errorf("Expected item centred at 0, 210 but got %v, %v",
+			transform.PositionX, transform.PositionY)
+	}
+	if transform.Rotation != 0 {
+		t.Errorf("Expected rotation to be reset but got %v", transform.Rotation)
+	}
+}
+
+func TestComposeGroupTransform(t *testing.T) {
+	tests := []struct {
+		name         string
+		group        typedefs.SceneItemTransform
+		child        typedefs.SceneItemTransform
+		wantX, wantY float64
+		wantScale    float64
+		wantRotation float64
+	}{
+		{
+			name: "scaled centred group",
+			group: typedefs.SceneItemTransform{
+				Alignment: alignments["center"],
+				PositionX: 960, PositionY: 540,
+				Width: 400, Height: 200,
+				ScaleX: 2, ScaleY: 2,
+			},
+			child: typedefs.SceneItemTransform{
+				PositionX: 10, PositionY: 20,
+				ScaleX: 1, ScaleY: 1,
+			},
+			wantX: 780, wantY: 480,
+			wantScale: 2,
+		},
+		{
+			name: "rotated top-left group",
+			group: typedefs.SceneItemTransform{
+				Alignment: alignments["top-left"],
+				PositionX: 100, PositionY: 100,
+				Width: 400, Height: 200,
+				ScaleX: 1, ScaleY: 1,
+				Rotation: 90,
+			},
+			child: typedefs.SceneItemTransform{
+				PositionX: 10, PositionY: 0,
+				ScaleX: 0.5, ScaleY: 0.5,
+			},
+			wantX: 100, wantY: 110,
+			wantScale:    0.5,
+			wantRotation: 90,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := composeGroupTransform(tt.group, tt.child)
+			if math.Abs(got.PositionX-tt.wantX) > 1e-9 || math.Abs(got.PositionY-tt.wantY) > 1e-9 {
+				t.Errorf("Expected position %v, %v but got %v, %v",
+					tt.wantX, tt.wantY, got.PositionX, got.PositionY)
+			}
+			if got.ScaleX != tt.wantScale || got.ScaleY != tt.wantScale {
+				t.Errorf("Expected scale %v but got %v, %v", tt.wantScale, got.ScaleX, got.ScaleY)
+			}
+			if got.Rotation != tt.wantRotation {
+				t.Errorf("Expected rotation %v but got %v", tt.wantRotation, got.Rotation)
+			}
+		})
+	}
+}
+
+func TestTransformValueParseArgs(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected float64
+	}{
+		{[]string{"--rotation", "-=90"}, -45},
+		{[]string{"--rotation", "-90"}, -90},
+		{[]string{"--rotation", "+=90"}, 135},
+		{[]string{"--rotation=-=90"}, -45},
+	}
+
+	for _, test := range tests {
+		var cli struct {
+			Transform SceneItemTransformCmd `cmd:""`
+		}
+		parser, err := kong.New(&cli)
+		if err != nil {
+			t.Fatalf("Failed to create parser: %v", err)
+		}
+		args := append([]string{"transform"}, test.args...)
+		if _, err := parser.Parse(append(args, "Scene", "Item")); err != nil {
+			t.Fatalf("Failed to parse %q: %v", test.args, err)
+		}
+		if cli.Transform.SceneName != "Scene" || cli.Transform.ItemName != "Item" {
+			t.Errorf("Expected args Scene and Item, got %s and %s",
+				cli.Transform.SceneName, cli.Transform.ItemName)
+		}
+
+		result := 45.0
+		cli.Transform.Rotation.applyTo(&result)
+		if result != test.expected {
+			t.Errorf("Expected %q applied to 45 to be %v but got %v", test.args, test.expected, result)
+		}
+	}
+}
+
+func TestTransformValueParseArgsShortFlag(t *testing.T) {
+	var cli struct {
+		Transform SceneItemTransformCmd `cmd:""`
+	}
+	parser, err := kong.New(&cli)
+	if err != nil {
+		t.Fatalf("Failed to create parser: %v", err)
+	}
+	if _, err := parser.Parse([]string{"transform", "--rotation", "-x", "Scene", "Item"}); err == nil {
+		t.Error("Expected error for a flag in place of a value, but got none")
+	}
+}
+
+func TestAlignmentAndBoundsTypeParseArgs(t *testing.T) {
+	tests := []struct {
+		args               []string
+		wantAlignment      float64
+		wantBoundsAlign    float64
+		wantBoundsType     string
+		wantAlignmentUnset bool
+	}{
+		{[]string{"--alignment", "top-left", "--bounds-type", "fit"}, 5, 0, "OBS_BOUNDS_SCALE_INNER", false},
+		{[]string{"--alignment=9", "--bounds-alignment", "10"}, 9, 10, "", false},
+		{[]string{"--bounds-type", "OBS_BOUNDS_STRETCH"}, 0, 0, "OBS_BOUNDS_STRETCH", true},
+	}
+
+	for _, test := range tests {
+		var cli struct {
+			Transform SceneItemTransformCmd `cmd:""`
+		}
+		parser, err := kong.New(&cli)
+		if err != nil {
+			t.Fatalf("Failed to create parser: %v", err)
+		}
+		args := append([]string{"transform"}, test.args...)
+		if _, err := parser.Parse(append(args, "Scene", "Item")); err != nil {
+			t.Fatalf("Failed to parse %q: %v", test.args, err)
+		}
+
+		if cli.Transform.Alignment.set == test.wantAlignmentUnset {
+			t.Errorf("Expected alignment set to be %v for %q", !test.wantAlignmentUnset, test.args)
+		}
+		if cli.Transform.Alignment.value != test.wantAlignment {
+			t.Errorf("Expected alignment %v for %q but got %v", test.wantAlignment, test.args, cli.Transform.Alignment.value)
+		}
+		if cli.Transform.BoundsAlignment.value != test.wantBoundsAlign {
+			t.Errorf("Expected bounds alignment %v for %q but got %v",
+				test.wantBoundsAlign, test.args, cli.Transform.BoundsAlignment.value)
+		}
+		if string(cli.Transform.BoundsType) != test.wantBoundsType {
+			t.Errorf("Expected bounds type %q for %q but got %q", test.wantBoundsType, test.args, cli.Transform.BoundsType)
+		}
+	}
+}
+
+func TestAlignmentAndBoundsTypeInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"--alignment", "middle"},
+		{"--alignment", "-1"},
+		{"--bounds-type", "OBS_BOUNDS_UNKNOWN"},
+	} {
+		var cli struct {
+			Transform SceneItemTransformCmd `cmd:""`
+		}
+		parser, err := kong.New(&cli)
+		if err != nil {
+			t.Fatalf("Failed to create parser: %v", err)
+		}
+		args = append([]string{"transform"}, args...)
+		if _, err := parser.Parse(append(args, "Scene", "Item")); err == nil {
+			t.Errorf("Expected error for %q, but got none", args)
+		}
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantCols int
+		wantRows int
+		wantOK   bool
+	}{
+		{"2x2", 2, 2, true},
+		{"3x1", 3, 1, true},
+		{"2x2junk", 0, 0, false},
+		{"2x", 0, 0, false},
+		{"x2", 0, 0, false},
+		{"0x2", 0, 0, false},
+		{"2x2x2", 0, 0, false},
+		{"2", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		cols, rows, ok := parseGrid(tt.input)
+		if cols != tt.wantCols || rows != tt.wantRows || ok != tt.wantOK {
+			t.Errorf("parseGrid(%q) = %d, %d, %v; expected %d, %d, %v",
+				tt.input, cols, rows, ok, tt.wantCols, tt.wantRows, tt.wantOK)
+		}
+	}
+}
@@ -3,10 +3,24 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/mattn/go-isatty"
+)
+
+var uuidPattern = regexp.MustCompile(
+	`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
 )
 
 func snakeCaseToTitleCase(snake string) string {
@@ -32,6 +46,53 @@ func getEnabledMark(enabled bool) string {
 	return "○"
 }
 
+// isUUID reports whether s is shaped like a UUID, as used by OBS for scenes, inputs and sources.
+func isUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
+
+// inputParams is implemented by request params which address an input by name or UUID.
+type inputParams[P any] interface {
+	WithInputName(name string) P
+	WithInputUuid(uuid string) P
+}
+
+// withInput addresses params to the input given by name or UUID.
+func withInput[P inputParams[P]](params P, input string) P {
+	if isUUID(input) {
+		return params.WithInputUuid(input)
+	}
+	return params.WithInputName(input)
+}
+
+// sourceParams is implemented by request params which address a source by name or UUID.
+type sourceParams[P any] interface {
+	WithSourceName(name string) P
+	WithSourceUuid(uuid string) P
+}
+
+// withSource addresses params to the source given by name or UUID.
+func withSource[P sourceParams[P]](params P, source string) P {
+	if isUUID(source) {
+		return params.WithSourceUuid(source)
+	}
+	return params.WithSourceName(source)
+}
+
+// sceneParams is implemented by request params which address a scene by name or UUID.
+type sceneParams[P any] interface {
+	WithSceneName(name string) P
+	WithSceneUuid(uuid string) P
+}
+
+// withScene addresses params to the scene given by name or UUID.
+func withScene[P sceneParams[P]](params P, scene string) P {
+	if isUUID(scene) {
+		return params.WithSceneUuid(scene)
+	}
+	return params.WithSceneName(scene)
+}
+
 func trimPrefix(s, prefix string) string {
 	if strings.HasPrefix(s, prefix) {
 		return s[len(prefix):]
@@ -76,3 +137,224 @@ func formatMillisecondsToTimeString(ms float64) string {
 	}
 	return fmt.Sprintf("%02d:%02d", minutes, seconds)
 }
+
+// decodeJSON decodes JSON keeping numbers as written, so integer settings are not sent as floats.
+func decodeJSON(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("unexpected data after JSON value")
+	}
+	return nil
+}
+
+// parseSettingValue interprets s as a JSON value (number, bool, object...) or, failing that, a plain string.
+func parseSettingValue(s string) any {
+	var value any
+	if err := decodeJSON([]byte(s), &value); err != nil {
+		return s
+	}
+	return value
+}
+
+// parseSettingPairs parses key=value arguments into a settings map.
+func parseSettingPairs(pairs []string) (map[string]any, error) {
+	settings := make(map[string]any, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid setting %q: expected key=value", pair)
+		}
+		settings[key] = parseSettingValue(value)
+	}
+	return settings, nil
+}
+
+// readFileOrStdin reads the file at path, or standard input if path is "-".
+func readFileOrStdin(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path) // nolint: gosec
+}
+
+// isTerminal reports whether w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && isatty.IsTerminal(f.Fd())
+}
+
+// readSettingsFile reads a JSON object of settings from path, or from stdin if path is "-".
+func readSettingsFile(path string) (map[string]any, error) {
+	data, err := readFileOrStdin(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+
+	var settings map[string]any
+	if err := decodeJSON(data, &settings); err != nil {
+		return nil, fmt.Errorf("failed to parse settings from %s: %w", path, err)
+	}
+	return settings, nil
+}
+
+// settingsFromArgs merges settings read from a JSON file, if any, with key=value arguments.
+// Arguments take precedence over the file.
+func settingsFromArgs(pairs []string, path string) (map[string]any, error) {
+	settings := make(map[string]any)
+	if path != "" {
+		fromFile, err := readSettingsFile(path)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(settings, fromFile)
+	}
+
+	fromPairs, err := parseSettingPairs(pairs)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(settings, fromPairs)
+
+	if len(settings) == 0 {
+		return nil, errors.New("no settings provided, pass key=value arguments or --settings-file")
+	}
+	return settings, nil
+}
+
+// numberValue is a numeric flag which accepts negative values without = (e.g. --to -30).
+type numberValue float64
+
+// Decode implements kong.MapperValue.
+func (v *numberValue) Decode(ctx *kong.DecodeContext) error {
+	// Negative values start with a hyphen, which kong would otherwise take for a short flag
+	if n, ok := hyphenNumber(ctx.Scan.Peek()); ok {
+		ctx.Scan.Pop()
+		*v = numberValue(n)
+		return nil
+	}
+
+	var s string
+	if err := ctx.Scan.PopValueInto("number", &s); err != nil {
+		return err
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("expected a number but got %q", s)
+	}
+	*v = numberValue(n)
+	return nil
+}
+
+// numberArgName is a positional argument followed by an optional numberValue argument, such as
+// the input name of input audio sync. kong takes a negative number for a short flag before the
+// numberValue is decoded, so a negative number after the name is passed on as a positional argument.
+type numberArgName string
+
+// Decode implements kong.MapperValue.
+func (a *numberArgName) Decode(ctx *kong.DecodeContext) error {
+	var s string
+	if err := ctx.Scan.PopValueInto("name", &s); err != nil {
+		return err
+	}
+	*a = numberArgName(s)
+
+	if t := ctx.Scan.Peek(); t.Type == kong.UntypedToken {
+		if _, ok := hyphenNumber(t); ok {
+			ctx.Scan.Pop()
+			ctx.Scan.PushTyped(t.Value, kong.PositionalArgumentToken)
+		}
+	}
+	return nil
+}
+
+// hyphenNumber returns the value of an untyped token which is a negative number.
+func hyphenNumber(t kong.Token) (float64, bool) {
+	s, ok := t.Value.(string)
+	if t.Type != kong.UntypedToken || !ok || !strings.HasPrefix(s, "-") {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	return n, err == nil
+}
+
+// SettingsFlags provides the arguments and flags for setting the settings of an input or filter.
+type SettingsFlags struct {
+	Settings []string `arg:"" help:"Settings to set as key=value, values are parsed as JSON where possible." optional:""`
+
+	SettingsFile string `flag:"" help:"Read settings from a JSON file, - for stdin."                                    placeholder:"FILE"`
+	Overlay      bool   `flag:"" help:"Apply on top of the current settings, otherwise unspecified settings are reset."                    default:"true" negatable:""`
+}
+
+// apply passes the settings given by the arguments and settings file to set, along with the overlay flag.
+// It returns the number of settings applied.
+func (f *SettingsFlags) apply(set func(settings map[string]any, overlay bool) error) (int, error) {
+	settings, err := settingsFromArgs(f.Settings, f.SettingsFile)
+	if err != nil {
+		return 0, err
+	}
+	if err := set(settings, f.Overlay); err != nil {
+		return 0, err
+	}
+	return len(settings), nil
+}
+
+// formatSettingValue formats a setting value for display, strings as-is and anything else as JSON.
+func formatSettingValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// splitCommandLine splits s into arguments on whitespace, honouring single and double quotes
+// and backslash escapes much like a POSIX shell.
+func splitCommandLine(s string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	if len(args) == 0 {
+		return nil, errors.New("empty command")
+	}
+	return args, nil
+}
@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
 
 func TestSnakeCaseToTitleCase(t *testing.T) {
 	tests := []struct {
@@ -18,3 +22,113 @@ func TestSnakeCaseToTitleCase(t *testing.T) {
 		}
 	}
 }
+
+func TestIsUUID(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"3f2504e0-4f89-11d3-9a0c-0305e82c3301", true},
+		{"3F2504E0-4F89-11D3-9A0C-0305E82C3301", true},
+		{"gobs-test-scene", false},
+		{"3f2504e0-4f89-11d3-9a0c-0305e82c330", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		result := isUUID(test.input)
+		if result != test.expected {
+			t.Errorf("Expected isUUID('%s') to be %v but got %v", test.input, test.expected, result)
+		}
+	}
+}
+
+func TestParseSettingPairs(t *testing.T) {
+	settings, err := parseSettingPairs([]string{
+		"url=https://example.com/?a=b",
+		"width=1920",
+		"opacity=0.5",
+		"is_local_file=false",
+		"font={\"face\":\"Arial\"}",
+		"text=",
+		"name=true story",
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse settings: %v", err)
+	}
+
+	expected := map[string]any{
+		"url":           "https://example.com/?a=b",
+		"width":         json.Number("1920"),
+		"opacity":       json.Number("0.5"),
+		"is_local_file": false,
+		"font":          map[string]any{"face": "Arial"},
+		"text":          "",
+		"name":          "true story",
+	}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Errorf("Expected %v but got %v", expected, settings)
+	}
+}
+
+func TestParseSettingPairsInvalid(t *testing.T) {
+	for _, pair := range []string{"width", "=1920"} {
+		if _, err := parseSettingPairs([]string{pair}); err == nil {
+			t.Errorf("Expected an error parsing %q", pair)
+		}
+	}
+}
+
+func TestFormatSettingValue(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected string
+	}{
+		{"https://example.com", "https://example.com"},
+		{float64(1920), "1920"},
+		{true, "true"},
+		{map[string]any{"face": "Arial"}, `{"face":"Arial"}`},
+		{nil, "null"},
+	}
+
+	for _, test := range tests {
+		result := formatSettingValue(test.input)
+		if result != test.expected {
+			t.Errorf("Expected '%s' but got '%s'", test.expected, result)
+		}
+	}
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"scene switch Live", []string{"scene", "switch", "Live"}},
+		{"scene switch 'Live Show'", []string{"scene", "switch", "Live Show"}},
+		{`scene switch "Live Show"`, []string{"scene", "switch", "Live Show"}},
+		{`scene switch Live\ Show`, []string{"scene", "switch", "Live Show"}},
+		{`text update Title ""`, []string{"text", "update", "Title", ""}},
+		{"  record   start ", []string{"record", "start"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := splitCommandLine(test.input)
+			if err != nil {
+				t.Fatalf("Failed to split '%s': %v", test.input, err)
+			}
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestSplitCommandLineInvalid(t *testing.T) {
+	for _, input := range []string{"", "   ", "scene switch 'Live", `scene switch Live\`} {
+		if _, err := splitCommandLine(input); err == nil {
+			t.Errorf("Expected error for '%s', but got none", input)
+		}
+	}
+}